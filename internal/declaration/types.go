@@ -15,13 +15,23 @@ type (
 		Name string
 	}
 	ParamMeta struct {
-		IsVararg bool
+		IsVararg  bool
+		IsContext bool
+	}
+	ResultMeta struct {
+		// IsError is set for types which implement error and can be nil.
+		IsError bool
+		// IsConcrete is set for non-interface types, a nil value of such type
+		// isn't a nil error once it's converted to one.
+		IsConcrete bool
+		// IsBool is set for bool and the types based on it.
+		IsBool bool
 	}
 	Func struct {
 		Name      string
 		Code      string
 		Params    []*Type[ParamMeta]
-		Results   []*Type[Empty]
+		Results   []*Type[ResultMeta]
 		Types     []*Type[TypeMeta]
 		Receivers []*Type[Empty]
 	}
@@ -40,3 +50,32 @@ type (
 		Position string
 	}
 )
+
+// HasError reports whether the last result is an error.
+func (f *Func) HasError() bool {
+	return len(f.Results) > 0 && f.Results[len(f.Results)-1].Meta.IsError
+}
+
+// HasConcreteError reports whether the last result is an error of a concrete
+// type (e.g. *MyError), which has to be checked for nil before it's wrapped.
+func (f *Func) HasConcreteError() bool {
+	return f.HasError() && f.Results[len(f.Results)-1].Meta.IsConcrete
+}
+
+// Values returns the results without the trailing error.
+func (f *Func) Values() []*Type[ResultMeta] {
+	if f.HasError() {
+		return f.Results[:len(f.Results)-1]
+	}
+	return f.Results
+}
+
+// IsCommaOK reports whether f returns a value and a bool like map lookups do.
+func (f *Func) IsCommaOK() bool {
+	return len(f.Results) == 2 && f.Results[1].Meta.IsBool
+}
+
+// TakesContext reports whether the first param is a context.Context.
+func (f *Func) TakesContext() bool {
+	return len(f.Params) > 0 && f.Params[0].Meta.IsContext
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
+	"strings"
 	"text/template"
 
 	"github.com/catmorte/go-wrap/internal/declaration"
@@ -27,35 +28,168 @@ import (
 {{end}}
 {{ else}}
 {{range .Funcs}}
-func {{if .Receivers}}({{range .Receivers}}rcv {{.Code}}{{end}}){{end -}}
-{{.Name}}Wrap{{- if .Types}}[{{range $index, $param := .Types}}{{.Meta.Name}} {{.Code}} {{if $index}}, {{end}} {{end}}]{{end -}}
-({{range $index, $param := .Params}}{{if $index}}, {{end}}arg{{$index}} {{.Code}}{{end}}) {{"" -}} 
-{{if eq (len .Results) 2 -}}
-	{{$.WrapPackageAlias}}Out[{{(index .Results 0).Code}}] {
-	return {{$.WrapPackageAlias}}Wrap({{if .Receivers}}{{range .Receivers}}rcv.{{end}}{{end}}{{.Name}}({{range $index, $param := .Params}}{{if $index}}, {{end}}arg{{$index}}{{if $param.Meta.IsVararg}}...{{end}} {{end}}))
-{{- else if eq (len .Results) 1 -}}
-	{{if eq (index .Results 0).Code "error" -}}
-		{{$.WrapPackageAlias}}Out[{{$.WrapPackageAlias}}Empty] {
-		return {{$.WrapPackageAlias}}Void({{if .Receivers}}{{range .Receivers}}rcv.{{end}}{{end}}{{.Name}}({{range $index, $param := .Params}}{{if $index}}, {{end}}arg{{$index}}{{if $param.Meta.IsVararg}}...{{end}} {{end}}))
-	{{- else -}}
-		{{$.WrapPackageAlias}}Out[{{(index .Results 0).Code}}] {
-		return {{$.WrapPackageAlias}}OK({{if .Receivers}}{{range .Receivers}}rcv.{{end}}{{end}}{{.Name}}({{range $index, $param := .Params}}{{if $index}}, {{end}}arg{{$index}}{{if $param.Meta.IsVararg}}...{{end}} {{end}}))
-	{{- end }}
-{{- else if eq (len .Results) 0 -}}
-	{{$.WrapPackageAlias}}Out[{{$.WrapPackageAlias}}Empty] {
-	{{if .Receivers}}{{range .Receivers}}rcv.{{end}}{{end}}{{.Name}}({{range $index, $param := .Params}}{{if $index}}, {{end}}arg{{$index}}{{if $param.Meta.IsVararg}}...{{end}} {{end}})
+{{- $values := .Values}}
+func {{template "receiver" .}}{{.Name}}Wrap{{template "signature" .}} {{$.WrapPackageAlias}}Out[{{$.ValueType .}}] {
+{{- if .TakesContext}}
+	if err := arg0.Err(); err != nil {
+		return {{$.WrapPackageAlias}}Err[{{$.ValueType .}}](err)
+	}
+{{- end}}
+{{- if $.WrapsCommaOK .}}
+	res0, ok := {{$.Call .}}
+	if !ok {
+		return {{$.WrapPackageAlias}}Err[{{$.ValueType .}}]({{if $.NotFound}}{{$.NotFound}}{{else}}{{$.WrapPackageAlias}}ErrNotFound{{end}})
+	}
+	return {{$.WrapPackageAlias}}OK(res0)
+{{- else if .HasConcreteError}}
+	{{range $index, $value := $values}}res{{$index}}, {{end}}err := {{$.Call .}}
+	if err != nil {
+		return {{$.WrapPackageAlias}}Err[{{$.ValueType .}}](err)
+	}
+	return {{$.WrapPackageAlias}}OK({{$.Value .}})
+{{- else if eq (len $values) 0}}
+	{{- if .HasError}}
+	return {{$.WrapPackageAlias}}Void({{$.Call .}})
+	{{- else}}
+	{{$.Call .}}
 	return {{$.WrapPackageAlias}}OK({{$.WrapPackageAlias}}Empty{})
-{{- end }} 
+	{{- end}}
+{{- else if eq (len $values) 1}}
+	{{- if .HasError}}
+	return {{$.WrapPackageAlias}}Wrap({{$.Call .}})
+	{{- else}}
+	return {{$.WrapPackageAlias}}OK({{$.Call .}})
+	{{- end}}
+{{- else}}
+	{{range $index, $value := $values}}{{if $index}}, {{end}}res{{$index}}{{end}}{{if .HasError}}, err{{end}} := {{$.Call .}}
+	{{- if .HasError}}
+	return {{$.WrapPackageAlias}}Wrap({{$.Value .}}, err)
+	{{- else}}
+	return {{$.WrapPackageAlias}}OK({{$.Value .}})
+	{{- end}}
+{{- end}}
+}
+{{if $.Async}}
+func {{template "receiver" .}}{{.Name}}WrapAsync{{template "signature" .}} {{$.WrapPackageAlias}}Out[{{$.ValueType .}}] {
+	{{- if .TakesContext}}
+	return {{$.WrapPackageAlias}}AsyncCtx(arg0, func(ctx {{(index .Params 0).Code}}) {{$.WrapPackageAlias}}Out[{{$.ValueType .}}] {
+		return {{template "wrapCall" .}}({{$.CtxArgs .}})
+	})
+	{{- else}}
+	return {{$.WrapPackageAlias}}Async(func() {{$.WrapPackageAlias}}Out[{{$.ValueType .}}] {
+		return {{template "wrapCall" .}}({{$.Args .}})
+	})
+	{{- end}}
 }
 {{end}}
 {{end}}
+{{end}}
+{{define "receiver"}}{{if .Receivers}}({{range .Receivers}}rcv {{.Code}}{{end}}) {{end}}{{end}}
+{{define "wrapCall"}}
+{{- if .Receivers}}rcv.{{end}}{{.Name}}Wrap
+{{- if .Types}}[{{range $index, $param := .Types}}{{if $index}}, {{end}}{{.Meta.Name}}{{end}}]{{end}}
+{{- end}}
+{{define "signature"}}
+{{- if .Types}}[{{range $index, $param := .Types}}{{if $index}}, {{end}}{{.Meta.Name}} {{.Code}}{{end}}]{{end -}}
+({{range $index, $param := .Params}}{{if $index}}, {{end}}arg{{$index}} {{.Code}}{{end}})
+{{- end}}
 `
 
-type fileTemplateData struct {
-	PackageName      string
-	WrapPackageAlias string
-	PrintRaw         bool
-	declaration.File
+type (
+	// Options tune the way wrappers are generated.
+	Options struct {
+		// CommaOK turns (V, bool) funcs into Out[V] instead of Out[Tuple2[V, bool]].
+		CommaOK bool
+		// NotFound is the error expression returned by comma-ok wrappers when the
+		// bool is false, ErrNotFound of the wrap package if empty.
+		NotFound string
+		// Async adds FooWrapAsync which runs FooWrap by Async for every FooWrap.
+		Async bool
+	}
+
+	fileTemplateData struct {
+		PackageName      string
+		WrapPackageAlias string
+		PrintRaw         bool
+		declaration.File
+		Options
+	}
+)
+
+// WrapsCommaOK reports whether f is wrapped as a comma-ok func.
+func (d fileTemplateData) WrapsCommaOK(f *declaration.Func) bool {
+	return d.CommaOK && f.IsCommaOK()
+}
+
+// ValueType returns the type of the value wrapped into Out by the wrapper of f:
+// Empty, the only value or a TupleN of the values.
+func (d fileTemplateData) ValueType(f *declaration.Func) string {
+	values := f.Values()
+	if d.WrapsCommaOK(f) {
+		values = values[:1]
+	}
+	switch len(values) {
+	case 0:
+		return d.WrapPackageAlias + "Empty"
+	case 1:
+		return values[0].Code
+	}
+	codes := make([]string, 0, len(values))
+	for _, v := range values {
+		codes = append(codes, v.Code)
+	}
+	return fmt.Sprintf("%sTuple%d[%s]", d.WrapPackageAlias, len(values), strings.Join(codes, ", "))
+}
+
+// Value returns the value wrapped into Out by the wrapper of f, built from
+// the results of the call named res0, res1 and so on.
+func (d fileTemplateData) Value(f *declaration.Func) string {
+	values := f.Values()
+	switch len(values) {
+	case 0:
+		return d.WrapPackageAlias + "Empty{}"
+	case 1:
+		return "res0"
+	}
+	fields := make([]string, 0, len(values))
+	for i := range values {
+		fields = append(fields, fmt.Sprintf("V%d: res%d", i+1, i))
+	}
+	return fmt.Sprintf("%s{%s}", d.ValueType(f), strings.Join(fields, ", "))
+}
+
+func args(f *declaration.Func) []string {
+	res := make([]string, 0, len(f.Params))
+	for i, p := range f.Params {
+		arg := fmt.Sprintf("arg%d", i)
+		if p.Meta.IsVararg {
+			arg += "..."
+		}
+		res = append(res, arg)
+	}
+	return res
+}
+
+// Args returns the arguments of the wrapper of f to pass them on.
+func (d fileTemplateData) Args(f *declaration.Func) string {
+	return strings.Join(args(f), ", ")
+}
+
+// CtxArgs works like Args, but the context is replaced with ctx given by
+// AsyncCtx.
+func (d fileTemplateData) CtxArgs(f *declaration.Func) string {
+	res := args(f)
+	res[0] = "ctx"
+	return strings.Join(res, ", ")
+}
+
+// Call returns the call of f with the arguments of its wrapper.
+func (d fileTemplateData) Call(f *declaration.Func) string {
+	rcv := ""
+	if len(f.Receivers) > 0 {
+		rcv = "rcv."
+	}
+	return fmt.Sprintf("%s%s(%s)", rcv, f.Name, d.Args(f))
 }
 
 func getWrapPrefix(imports []*declaration.Import) string {
@@ -84,12 +218,13 @@ func executeTemplate[T any](t *template.Template, data T) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func newFileTemplateData(prefix string, packageName string, f declaration.File, printRaw bool) fileTemplateData {
+func newFileTemplateData(prefix string, packageName string, f declaration.File, printRaw bool, opts Options) fileTemplateData {
 	return fileTemplateData{
 		PackageName:      packageName,
 		File:             f,
 		WrapPackageAlias: prefix,
 		PrintRaw:         printRaw,
+		Options:          opts,
 	}
 }
 
@@ -101,9 +236,9 @@ func formatImports(b []byte) ([]byte, error) {
 	return imports.Process("", b, nil)
 }
 
-func Generate(packageName string, f declaration.File, printRaw bool) Out[[]byte] {
+func Generate(packageName string, f declaration.File, printRaw bool, opts Options) Out[[]byte] {
 	gotWrapPreifx := getWrapPrefixWrap(f.Imports)
-	templateDataCreated := AndX4Async(gotWrapPreifx, OK(packageName), OK(f), OK(printRaw), newFileTemplateDataWrap)
+	templateDataCreated := AndX5Async(gotWrapPreifx, OK(packageName), OK(f), OK(printRaw), OK(opts), newFileTemplateDataWrap)
 	templateParsed := parseTemplateWrap()
 	return AndX2Async(templateParsed, templateDataCreated, func(t *template.Template, data fileTemplateData) Out[[]byte] {
 		codeGenerated := executeTemplateWrap(t, data)
@@ -8,6 +8,10 @@ import (
 	. "github.com/catmorte/go-wrap/pkg/wrap"
 )
 
+func argsWrap(arg0 *declaration.Func) Out[[]string] {
+	return OK(args(arg0))
+}
+
 func getWrapPrefixWrap(arg0 []*declaration.Import) Out[string] {
 	return OK(getWrapPrefix(arg0))
 }
@@ -20,8 +24,8 @@ func executeTemplateWrap[T any](arg0 *template.Template, arg1 T) Out[[]byte] {
 	return Wrap(executeTemplate(arg0, arg1))
 }
 
-func newFileTemplateDataWrap(arg0 string, arg1 string, arg2 declaration.File, arg3 bool) Out[fileTemplateData] {
-	return OK(newFileTemplateData(arg0, arg1, arg2, arg3))
+func newFileTemplateDataWrap(arg0 string, arg1 string, arg2 declaration.File, arg3 bool, arg4 Options) Out[fileTemplateData] {
+	return OK(newFileTemplateData(arg0, arg1, arg2, arg3, arg4))
 }
 
 func formatSourceWrap(arg0 []byte) Out[[]byte] {
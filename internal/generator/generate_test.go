@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/catmorte/go-wrap/internal/declaration"
+	. "github.com/catmorte/go-wrap/pkg/wrap"
+	"github.com/catmorte/go-wrap/pkg/wraptest"
+)
+
+func param(code string) *declaration.Type[declaration.ParamMeta] {
+	return &declaration.Type[declaration.ParamMeta]{Code: code, Meta: declaration.ParamMeta{
+		IsVararg:  len(code) > 3 && code[:3] == "...",
+		IsContext: code == "context.Context",
+	}}
+}
+
+func result(code string, meta declaration.ResultMeta) *declaration.Type[declaration.ResultMeta] {
+	return &declaration.Type[declaration.ResultMeta]{Code: code, Meta: meta}
+}
+
+var (
+	errorMeta    = declaration.ResultMeta{IsError: true}
+	concreteMeta = declaration.ResultMeta{IsError: true, IsConcrete: true}
+	boolMeta     = declaration.ResultMeta{IsConcrete: true, IsBool: true}
+	valueMeta    = declaration.ResultMeta{IsConcrete: true}
+)
+
+// sample has a func for every branch of the template.
+var sample = declaration.File{
+	Path: "sample.go",
+	Imports: []*declaration.Import{
+		{Path: "context"},
+		{Alias: "goWrap0", Path: declaration.WrapPkgPath},
+	},
+	Funcs: []*declaration.Func{
+		{Name: "Run", Code: "func Run() {}"},
+		{Name: "Close", Code: "func Close() error { return nil }", Results: []*declaration.Type[declaration.ResultMeta]{
+			result("error", errorMeta),
+		}},
+		{Name: "Name", Code: `func Name() string { return "" }`, Results: []*declaration.Type[declaration.ResultMeta]{
+			result("string", valueMeta),
+		}},
+		{Name: "Load", Code: "func Load(key string) (int, error) { return 0, nil }", Params: []*declaration.Type[declaration.ParamMeta]{
+			param("string"),
+		}, Results: []*declaration.Type[declaration.ResultMeta]{
+			result("int", valueMeta), result("error", errorMeta),
+		}},
+		{Name: "Pair", Code: `func Pair() (int, string) { return 0, "" }`, Results: []*declaration.Type[declaration.ResultMeta]{
+			result("int", valueMeta), result("string", valueMeta),
+		}},
+		{Name: "Triple", Code: `func Triple() (int, string, error) { return 0, "", nil }`, Results: []*declaration.Type[declaration.ResultMeta]{
+			result("int", valueMeta), result("string", valueMeta), result("error", errorMeta),
+		}},
+		{Name: "Lookup", Code: "func Lookup(key string) (int, bool) { return 0, false }", Params: []*declaration.Type[declaration.ParamMeta]{
+			param("string"),
+		}, Results: []*declaration.Type[declaration.ResultMeta]{
+			result("int", valueMeta), result("bool", boolMeta),
+		}},
+		{Name: "Check", Code: "func Check() (int, *MyError) { return 0, nil }", Results: []*declaration.Type[declaration.ResultMeta]{
+			result("int", valueMeta), result("*MyError", concreteMeta),
+		}},
+		{Name: "Validate", Code: "func Validate() *MyError { return nil }", Results: []*declaration.Type[declaration.ResultMeta]{
+			result("*MyError", concreteMeta),
+		}},
+		{Name: "Fetch", Code: `func Fetch(ctx context.Context, id int) (string, error) { return "", nil }`, Params: []*declaration.Type[declaration.ParamMeta]{
+			param("context.Context"), param("int"),
+		}, Results: []*declaration.Type[declaration.ResultMeta]{
+			result("string", valueMeta), result("error", errorMeta),
+		}},
+		{Name: "Sum", Code: "func Sum(v ...int) int { return 0 }", Params: []*declaration.Type[declaration.ParamMeta]{
+			param("...int"),
+		}, Results: []*declaration.Type[declaration.ResultMeta]{
+			result("int", valueMeta),
+		}},
+		{Name: "First", Code: "func First[T any](v []T) (T, error) { var t T; return t, nil }", Types: []*declaration.Type[declaration.TypeMeta]{
+			{Code: "any", Meta: declaration.TypeMeta{Name: "T"}},
+		}, Params: []*declaration.Type[declaration.ParamMeta]{
+			param("[]T"),
+		}, Results: []*declaration.Type[declaration.ResultMeta]{
+			result("T", declaration.ResultMeta{}), result("error", errorMeta),
+		}},
+		{Name: "Get", Code: "func (c *Client) Get(ctx context.Context) error { return nil }", Receivers: []*declaration.Type[Empty]{
+			{Code: "*Client"},
+		}, Params: []*declaration.Type[declaration.ParamMeta]{
+			param("context.Context"),
+		}, Results: []*declaration.Type[declaration.ResultMeta]{
+			result("error", errorMeta),
+		}},
+	},
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		printRaw bool
+		opts     Options
+	}{
+		{name: "default"},
+		{name: "raw", printRaw: true},
+		{name: "comma-ok", opts: Options{CommaOK: true, NotFound: "ErrMissing"}},
+		{name: "async", opts: Options{CommaOK: true, Async: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wraptest.RequireOK(t, Generate("sample", sample, tt.printRaw, tt.opts))
+			path := filepath.Join("testdata", tt.name+".golden")
+			if os.Getenv(wraptest.UpdateEnv) != "" {
+				if err := os.WriteFile(path, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"bytes"
 	"go/ast"
 	"go/printer"
+	"go/types"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 
@@ -19,6 +21,8 @@ type packageParser struct {
 	*packages.Package
 }
 
+var errorInterface = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
 func loadPackages(path string, cfg *packages.Config) ([]*packages.Package, error) {
 	return packages.Load(cfg, path+"/...")
 }
@@ -36,29 +40,86 @@ func (p packageParser) parseError(err packages.Error) *declaration.Error {
 	}
 }
 
-func (p packageParser) newType(f *ast.Field) *declaration.Type[Empty] {
+func (p packageParser) newType(f *ast.Field, _ *ast.Ident) *declaration.Type[Empty] {
 	return &declaration.Type[Empty]{Code: p.extractRawCode(f.Type)}
 }
 
-func (p packageParser) newTypeParams(f *ast.Field) *declaration.Type[declaration.ParamMeta] {
+func (p packageParser) newTypeParams(f *ast.Field, _ *ast.Ident) *declaration.Type[declaration.ParamMeta] {
 	code := p.extractRawCode(f.Type)
+	isContext := code == "context.Context"
+	if p.TypesInfo != nil {
+		if t := p.TypesInfo.TypeOf(f.Type); t != nil {
+			isContext = isContextType(t)
+		}
+	}
 	return &declaration.Type[declaration.ParamMeta]{
 		Code: code,
 		Meta: declaration.ParamMeta{
-			IsVararg: strings.HasPrefix(code, "..."),
+			IsVararg:  strings.HasPrefix(code, "..."),
+			IsContext: isContext,
+		},
+	}
+}
+
+// newTypeResult tells errors and bools by the types info, so custom error
+// types and named bools are recognized too, the code is used as a fallback if
+// the type is unknown.
+func (p packageParser) newTypeResult(f *ast.Field, _ *ast.Ident) *declaration.Type[declaration.ResultMeta] {
+	code := p.extractRawCode(f.Type)
+	var t types.Type
+	if p.TypesInfo != nil {
+		t = p.TypesInfo.TypeOf(f.Type)
+	}
+	if t == nil {
+		return &declaration.Type[declaration.ResultMeta]{
+			Code: code,
+			Meta: declaration.ResultMeta{IsError: code == "error", IsBool: code == "bool"},
+		}
+	}
+	return &declaration.Type[declaration.ResultMeta]{
+		Code: code,
+		Meta: declaration.ResultMeta{
+			IsError:    isNillable(t) && types.Implements(t, errorInterface),
+			IsConcrete: !types.IsInterface(t),
+			IsBool:     isBool(t),
 		},
 	}
 }
 
-func (p packageParser) newTypeTypeArg(f *ast.Field) *declaration.Type[declaration.TypeMeta] {
+func (p packageParser) newTypeTypeArg(f *ast.Field, name *ast.Ident) *declaration.Type[declaration.TypeMeta] {
 	return &declaration.Type[declaration.TypeMeta]{
 		Code: p.extractRawCode(f.Type),
 		Meta: declaration.TypeMeta{
-			Name: f.Names[0].Name,
+			Name: name.Name,
 		},
 	}
 }
 
+func isNillable(t types.Type) bool {
+	if _, ok := t.(*types.TypeParam); ok {
+		return false
+	}
+	switch t.Underlying().(type) {
+	case *types.Pointer, *types.Interface, *types.Map, *types.Slice, *types.Chan, *types.Signature:
+		return true
+	}
+	return false
+}
+
+func isBool(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Kind() == types.Bool
+}
+
+func isContextType(t types.Type) bool {
+	n, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := n.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context"
+}
+
 func newPackageParser(p *packages.Package) packageParser {
 	return packageParser{p}
 }
@@ -67,13 +128,21 @@ func unquote(v string) (string, error) {
 	return strconv.Unquote(v)
 }
 
-func parseFields[T any](l *ast.FieldList, fn func(f *ast.Field) *declaration.Type[T]) []*declaration.Type[T] {
+// parseFields returns a type per name, so (a, b int) gives two of them,
+// name is nil for unnamed fields.
+func parseFields[T any](l *ast.FieldList, fn func(f *ast.Field, name *ast.Ident) *declaration.Type[T]) []*declaration.Type[T] {
 	if l == nil {
 		return nil
 	}
-	res := make([]*declaration.Type[T], 0, len(l.List))
+	res := make([]*declaration.Type[T], 0, l.NumFields())
 	for _, f := range l.List {
-		res = append(res, fn(f))
+		if len(f.Names) == 0 {
+			res = append(res, fn(f, nil))
+			continue
+		}
+		for _, name := range f.Names {
+			res = append(res, fn(f, name))
+		}
 	}
 	return res
 }
@@ -94,7 +163,7 @@ func (p packageParser) newFunc(fn *ast.FuncDecl) *declaration.Func {
 		Code:      p.extractRawCode(fn),
 		Params:    parseFields(fn.Type.Params, p.newTypeParams),
 		Receivers: parseFields(fn.Recv, p.newType),
-		Results:   parseFields(fn.Type.Results, p.newType),
+		Results:   parseFields(fn.Type.Results, p.newTypeResult),
 		Types:     parseFields(fn.Type.TypeParams, p.newTypeTypeArg),
 	}
 }
@@ -136,12 +205,13 @@ func Parse(path string) []Out[*declaration.Package] {
 	}
 	packagesLoaded := DisJoin(loadPackagesWrap(path, cfg))
 	convertedToPackageParsers := EachAsync(packagesLoaded, newPackageParserWrap)
+	workers := NewPool(runtime.NumCPU())
 	return Each(convertedToPackageParsers, func(p packageParser) Out[*declaration.Package] {
 		errorsParsed := EachAsync(OKVargs(p.Errors...), p.parseErrorWrap)
 		filesDisJoined := DisJoin(OK(p.Syntax))
-		filesParsed := EachAsync(filesDisJoined, func(f *ast.File) Out[*declaration.File] {
+		filesParsed := EachAsyncOn(workers, filesDisJoined, func(f *ast.File) Out[*declaration.File] {
 			fullPath := OK(p.Fset.Position(f.Pos()).Filename)
-			funcsConverted := EachAsync(OKSlice(filterFuncDecls(f.Decls)), p.newFuncWrap)
+			funcsConverted := EachAsyncOn(workers, OKSlice(filterFuncDecls(f.Decls)), p.newFuncWrap)
 			importsProcessed := EachAsync(OKSlice(f.Imports), p.newImportWrap)
 			funcsJoined := JoinAsync(funcsConverted)
 			importsJoined := JoinAsync(importsProcessed)
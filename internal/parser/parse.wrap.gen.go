@@ -3,6 +3,7 @@ package parser
 
 import (
 	"go/ast"
+	"go/types"
 
 	"github.com/catmorte/go-wrap/internal/declaration"
 	. "github.com/catmorte/go-wrap/pkg/wrap"
@@ -21,16 +22,32 @@ func (rcv packageParser) parseErrorWrap(arg0 packages.Error) Out[*declaration.Er
 	return OK(rcv.parseError(arg0))
 }
 
-func (rcv packageParser) newTypeWrap(arg0 *ast.Field) Out[*declaration.Type[Empty]] {
-	return OK(rcv.newType(arg0))
+func (rcv packageParser) newTypeWrap(arg0 *ast.Field, arg1 *ast.Ident) Out[*declaration.Type[Empty]] {
+	return OK(rcv.newType(arg0, arg1))
 }
 
-func (rcv packageParser) newTypeParamsWrap(arg0 *ast.Field) Out[*declaration.Type[declaration.ParamMeta]] {
-	return OK(rcv.newTypeParams(arg0))
+func (rcv packageParser) newTypeParamsWrap(arg0 *ast.Field, arg1 *ast.Ident) Out[*declaration.Type[declaration.ParamMeta]] {
+	return OK(rcv.newTypeParams(arg0, arg1))
 }
 
-func (rcv packageParser) newTypeTypeArgWrap(arg0 *ast.Field) Out[*declaration.Type[declaration.TypeMeta]] {
-	return OK(rcv.newTypeTypeArg(arg0))
+func (rcv packageParser) newTypeResultWrap(arg0 *ast.Field, arg1 *ast.Ident) Out[*declaration.Type[declaration.ResultMeta]] {
+	return OK(rcv.newTypeResult(arg0, arg1))
+}
+
+func (rcv packageParser) newTypeTypeArgWrap(arg0 *ast.Field, arg1 *ast.Ident) Out[*declaration.Type[declaration.TypeMeta]] {
+	return OK(rcv.newTypeTypeArg(arg0, arg1))
+}
+
+func isNillableWrap(arg0 types.Type) Out[bool] {
+	return OK(isNillable(arg0))
+}
+
+func isBoolWrap(arg0 types.Type) Out[bool] {
+	return OK(isBool(arg0))
+}
+
+func isContextTypeWrap(arg0 types.Type) Out[bool] {
+	return OK(isContextType(arg0))
 }
 
 func newPackageParserWrap(arg0 *packages.Package) Out[packageParser] {
@@ -41,7 +58,7 @@ func unquoteWrap(arg0 string) Out[string] {
 	return Wrap(unquote(arg0))
 }
 
-func parseFieldsWrap[T any](arg0 *ast.FieldList, arg1 func(f *ast.Field) *declaration.Type[T]) Out[[]*declaration.Type[T]] {
+func parseFieldsWrap[T any](arg0 *ast.FieldList, arg1 func(f *ast.Field, name *ast.Ident) *declaration.Type[T]) Out[[]*declaration.Type[T]] {
 	return OK(parseFields(arg0, arg1))
 }
 
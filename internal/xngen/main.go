@@ -0,0 +1,273 @@
+// xngen generates the handlers of pkg/wrap which come in different arities
+// (SuccessorXN, AndXN and their variants), so all of them stay consistent.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+const fileTemplate = `
+// Code generated by "xngen"; DO NOT EDIT.
+package wrap
+
+import "context"
+
+type (
+{{range .Arities}}	SuccessorX{{.}}[{{list "T%d" . ", "}}, TT any] func({{list "T%d" . ", "}}) Out[TT]
+{{end -}}
+)
+{{range .Arities}}
+func AndX{{.}}[{{list "T%d" . ", "}}, TT any]({{list "r%[1]d Out[T%[1]d]" . ", "}}, f SuccessorX{{.}}[{{list "T%d" . ", "}}, TT]) Out[TT] {
+	allGood := Proof({{list "r%d" . ", "}})
+	return andX{{.}}(callSite(), allGood, {{list "r%d" . ", "}}, f)
+}
+
+func AndX{{.}}Async[{{list "T%d" . ", "}}, TT any]({{list "r%[1]d Out[T%[1]d]" . ", "}}, f SuccessorX{{.}}[{{list "T%d" . ", "}}, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof({{list "r%d" . ", "}})
+		return andX{{.}}(site, allGood, {{list "r%d" . ", "}}, f)
+	})
+}
+
+// AndX{{.}}AsyncCtx works like AndX{{.}}Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX{{.}}AsyncCtx[{{list "T%d" . ", "}}, TT any](ctx context.Context, {{list "r%[1]d Out[T%[1]d]" . ", "}}, f SuccessorX{{.}}[{{list "T%d" . ", "}}, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof({{list "r%d" . ", "}}), ctxSuccessor(ctx, OK[Empty]))
+		return andX{{.}}(site, allGood, {{list "r%d" . ", "}}, f)
+	})
+}
+
+// AndX{{.}}All works like AndX{{.}}, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX{{.}}All[{{list "T%d" . ", "}}, TT any]({{list "r%[1]d Out[T%[1]d]" . ", "}}, f SuccessorX{{.}}[{{list "T%d" . ", "}}, TT]) Out[TT] {
+	allGood := ProofAll({{list "r%d" . ", "}})
+	return andX{{.}}(callSite(), allGood, {{list "r%d" . ", "}}, f)
+}
+
+func AndX{{.}}AllAsync[{{list "T%d" . ", "}}, TT any]({{list "r%[1]d Out[T%[1]d]" . ", "}}, f SuccessorX{{.}}[{{list "T%d" . ", "}}, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll({{list "r%d" . ", "}})
+		return andX{{.}}(site, allGood, {{list "r%d" . ", "}}, f)
+	})
+}
+
+func andX{{.}}[{{list "T%d" . ", "}}, TT any](site string, allGood Out[Empty], {{list "r%[1]d Out[T%[1]d]" . ", "}}, f SuccessorX{{.}}[{{list "T%d" . ", "}}, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+{{list "var defaultV%[1]d T%[1]d\n" . ""}}		return f({{list "r%[1]d.GetOrDefault(defaultV%[1]d),\n" . ""}})
+	})
+}
+{{end}}
+// MaxTupleArity is the biggest N of TupleN, so it's the most values a func
+// wrapped by go-wrap can return.
+const MaxTupleArity = {{.MaxTupleArity}}
+{{range .TupleArities}}
+type Tuple{{.}}[{{list "T%d" . ", "}} any] struct {
+{{list "V%[1]d T%[1]d\n" . ""}}}
+
+func (t Tuple{{.}}[{{list "T%d" . ", "}}]) Unpack() ({{list "T%d" . ", "}}) {
+	return {{list "t.V%d" . ", "}}
+}
+
+// Zip{{.}} gathers the values of all the arguments into Tuple{{.}}, see AndX{{.}}.
+func Zip{{.}}[{{list "T%d" . ", "}} any]({{list "r%[1]d Out[T%[1]d]" . ", "}}) Out[Tuple{{.}}[{{list "T%d" . ", "}}]] {
+	return AndX{{.}}({{list "r%d" . ", "}}, func({{list "v%[1]d T%[1]d" . ", "}}) Out[Tuple{{.}}[{{list "T%d" . ", "}}]] {
+		return OK(Tuple{{.}}[{{list "T%d" . ", "}}]{ {{- list "V%[1]d: v%[1]d" . ", " -}} })
+	})
+}
+
+func Zip{{.}}Async[{{list "T%d" . ", "}} any]({{list "r%[1]d Out[T%[1]d]" . ", "}}) Out[Tuple{{.}}[{{list "T%d" . ", "}}]] {
+	return Async(func() Out[Tuple{{.}}[{{list "T%d" . ", "}}]] {
+		return Zip{{.}}({{list "r%d" . ", "}})
+	})
+}
+
+// Unzip{{.}} splits the result of Zip{{.}} back, an error goes to all the parts.
+func Unzip{{.}}[{{list "T%d" . ", "}} any](r Out[Tuple{{.}}[{{list "T%d" . ", "}}]]) ({{list "Out[T%d]" . ", "}}) {
+	v, err := r.Unwrap()
+	if err != nil {
+		return {{list "Err[T%d](err)" . ", "}}
+	}
+	return {{list "OK(v.V%d)" . ", "}}
+}
+{{end}}
+`
+
+const testTemplate = `
+// Code generated by "xngen"; DO NOT EDIT.
+package wrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+var andXNCases = []struct {
+	name  string
+	arity int
+	// all is set for the handlers which join the errors of all the arguments.
+	all  bool
+	call func(r []Out[int], f func(v ...int) Out[int]) Out[int]
+}{
+{{- range .Arities}}
+	{name: "AndX{{.}}", arity: {{.}}, call: func(r []Out[int], f func(v ...int) Out[int]) Out[int] {
+		return AndX{{.}}({{list "r[%d]" . ", " -1}}, func({{list "v%d" . ", " 0}} int) Out[int] {
+			return f({{list "v%d" . ", " 0}})
+		})
+	}},
+	{name: "AndX{{.}}Async", arity: {{.}}, call: func(r []Out[int], f func(v ...int) Out[int]) Out[int] {
+		return AndX{{.}}Async({{list "r[%d]" . ", " -1}}, func({{list "v%d" . ", " 0}} int) Out[int] {
+			return f({{list "v%d" . ", " 0}})
+		})
+	}},
+	{name: "AndX{{.}}AsyncCtx", arity: {{.}}, call: func(r []Out[int], f func(v ...int) Out[int]) Out[int] {
+		return AndX{{.}}AsyncCtx(context.Background(), {{list "r[%d]" . ", " -1}}, func({{list "v%d" . ", " 0}} int) Out[int] {
+			return f({{list "v%d" . ", " 0}})
+		})
+	}},
+	{name: "AndX{{.}}All", arity: {{.}}, all: true, call: func(r []Out[int], f func(v ...int) Out[int]) Out[int] {
+		return AndX{{.}}All({{list "r[%d]" . ", " -1}}, func({{list "v%d" . ", " 0}} int) Out[int] {
+			return f({{list "v%d" . ", " 0}})
+		})
+	}},
+	{name: "AndX{{.}}AllAsync", arity: {{.}}, all: true, call: func(r []Out[int], f func(v ...int) Out[int]) Out[int] {
+		return AndX{{.}}AllAsync({{list "r[%d]" . ", " -1}}, func({{list "v%d" . ", " 0}} int) Out[int] {
+			return f({{list "v%d" . ", " 0}})
+		})
+	}},
+{{- end}}
+}
+
+func okArgs(n int) []Out[int] {
+	res := make([]Out[int], 0, n)
+	for i := 0; i < n; i++ {
+		res = append(res, OK(i))
+	}
+	return res
+}
+
+func TestAndXN(t *testing.T) {
+	errArg := errors.New("argument error")
+	for _, tt := range andXNCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			res := tt.call(okArgs(tt.arity), func(v ...int) Out[int] {
+				got = v
+				return OK(len(v))
+			})
+			if n, err := res.Unwrap(); err != nil || n != tt.arity {
+				t.Fatalf("got %v, %v, want %v", n, err, tt.arity)
+			}
+			want := []int{}
+			for i := 0; i < tt.arity; i++ {
+				want = append(want, i)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("f got %v, want %v", got, want)
+			}
+			for pos := 0; pos < tt.arity; pos++ {
+				t.Run(fmt.Sprintf("err at #%d", pos), func(t *testing.T) {
+					r := okArgs(tt.arity)
+					r[pos] = Err[int](errArg)
+					called := false
+					err := tt.call(r, func(...int) Out[int] {
+						called = true
+						return OK(0)
+					}).ErrorOrNil()
+					if called {
+						t.Fatal("f is called")
+					}
+					if !errors.Is(err, errArg) {
+						t.Fatalf("got error %v, want %v", err, errArg)
+					}
+					var indexErr *IndexError
+					if tt.all && (!errors.As(err, &indexErr) || indexErr.Index != pos) {
+						t.Fatalf("got error %v, want it at #%d", err, pos)
+					}
+				})
+			}
+		})
+	}
+}
+`
+
+type fileTemplateData struct {
+	Arities       []int
+	TupleArities  []int
+	MaxTupleArity int
+}
+
+// list formats the pattern for each number from 1 to n and joins the results,
+// the numbers can be shifted by the optional offset.
+func list(pattern string, n int, sep string, offset ...int) string {
+	shift := 0
+	for _, v := range offset {
+		shift += v
+	}
+	res := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		res = append(res, fmt.Sprintf(pattern, i+shift))
+	}
+	return strings.Join(res, sep)
+}
+
+func newFileTemplateData(maxArity int, maxTupleArity int) fileTemplateData {
+	data := fileTemplateData{}
+	for i := 2; i <= maxArity; i++ {
+		data.Arities = append(data.Arities, i)
+	}
+	for i := 2; i <= maxTupleArity && i <= maxArity; i++ {
+		data.TupleArities = append(data.TupleArities, i)
+		data.MaxTupleArity = i
+	}
+	return data
+}
+
+func generate(text string, data fileTemplateData) ([]byte, error) {
+	t, err := template.New("").Funcs(template.FuncMap{"list": list}).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	if err := t.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func main() {
+	outFlag := flag.String("out", "xn.gen.go", "output file")
+	testOutFlag := flag.String("test-out", "", "output file of the tests, none if empty")
+	maxFlag := flag.Int("max", 16, "max arity of AndXN")
+	maxTupleFlag := flag.Int("max-tuple", 9, "max arity of TupleN and ZipN, can't be more than -max")
+	flag.Parse()
+
+	// pkg/wrap isn't used here on purpose: a broken output would make this
+	// generator unable to compile and fix it.
+	data := newFileTemplateData(*maxFlag, *maxTupleFlag)
+	files := map[string]string{*outFlag: fileTemplate}
+	if *testOutFlag != "" {
+		files[*testOutFlag] = testTemplate
+	}
+	for path, text := range files {
+		raw, err := generate(text, data)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(path, raw, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -51,9 +51,14 @@ var modes = map[string]func(*Func) bool{
 func filterFuncs(fs []*Func, modeFunc func(*Func) bool, excludedFuncs []string) []*Func {
 	res := []*Func{}
 	for _, f := range fs {
-		if modeFunc(f) && !slices.Contains(excludedFuncs, f.Name) && len(f.Results) <= 1 || (len(f.Results) == 2 && f.Results[1].Code == "error") {
-			res = append(res, f)
+		if !modeFunc(f) || slices.Contains(excludedFuncs, f.Name) {
+			continue
+		}
+		if n := len(f.Values()); n > MaxTupleArity {
+			log.Printf("%s is skipped: it returns %d values, at most %d can be wrapped", f.Name, n, MaxTupleArity)
+			continue
 		}
+		res = append(res, f)
 	}
 	return res
 }
@@ -129,7 +134,12 @@ func main() {
 	fileFlag := flag.String("file", "", "file")
 	modeFlag := flag.String("mode", "all", "funcs/methods which needs to be wrapped: all/pub/priv[-rcv/-fun]")
 	excludeFlag := flag.String("exclude", "", "coma separated list of funcs/methods to exclude")
+	debugFlag := flag.Bool("debug", false, "report file and line of the failed step")
+	commaOKFlag := flag.Bool("comma-ok", false, "wrap (V, bool) funcs into Out[V] which is an error if the bool is false")
+	asyncFlag := flag.Bool("async", false, "generate FooWrapAsync running FooWrap by Async for every FooWrap")
+	notFoundFlag := flag.String("not-found", "", "error expression returned by comma-ok wrappers, ErrNotFound of the wrap package by default")
 	flag.Parse()
+	SetDebug(*debugFlag)
 	file := os.Getenv("GOFILE")
 
 	if file == "" {
@@ -149,12 +159,14 @@ func main() {
 		excludedFuncs = strings.Split(*excludeFlag, ",")
 	}
 
-	pathGot := Wrap(os.Getwd())
+	opts := generator.Options{CommaOK: *commaOKFlag, NotFound: *notFoundFlag, Async: *asyncFlag}
+
+	pathGot := Named("get working dir", Wrap(os.Getwd()))
 	fileSaved := And(pathGot, func(path string) Out[string] {
 		fullPath := filepath.Join(path, file)
 		packagesParsed := parser.Parse(path)
-		packagesJoined := JoinAsync(packagesParsed)
-		return And(packagesJoined, func(ps []*Package) Out[string] {
+		packagesJoined := Named("parse packages", JoinAsync(packagesParsed))
+		return AndNamed("find file", packagesJoined, func(ps []*Package) Out[string] {
 			p, f := findPackageAndFileByPath(fullPath, ps)
 			if p == nil || f == nil {
 				return Err[string](fmt.Errorf("file %v not found", fullPath))
@@ -176,16 +188,16 @@ func main() {
 			}
 			fileName := fmt.Sprintf("%s.wrap.gen.go", strings.TrimSuffix(file, filepath.Ext(file)))
 			fullPath := filepath.Join(path, fileName)
-			codeGenerated := generator.Generate(p.Name, *f, false)
-			return And(codeGenerated, func(raw []byte) Out[string] {
+			codeGenerated := Named("generate wrappers", generator.Generate(p.Name, *f, false, opts))
+			return AndNamed("write wrappers", codeGenerated, func(raw []byte) Out[string] {
 				return Wrap(fullPath, os.WriteFile(fullPath, raw, 0644))
 			})
 		})
 	})
 	AndX2(pathGot, fileSaved, func(path, filePath string) Out[Empty] {
 		packagesParsed := parser.Parse(path)
-		packagesJoined := JoinAsync(packagesParsed)
-		return And(packagesJoined, func(ps []*Package) Out[Empty] {
+		packagesJoined := Named("parse generated wrappers", JoinAsync(packagesParsed))
+		return AndNamed("find generated file", packagesJoined, func(ps []*Package) Out[Empty] {
 			p, f := findPackageAndFileByPath(filePath, ps)
 			if p == nil || f == nil {
 				return Err[Empty](fmt.Errorf("file %v not found", filePath))
@@ -193,8 +205,8 @@ func main() {
 			unusedImports := findUnusedDotImportsForFile(filePath, p.Errors)
 			f.Imports = removeUnusedDotImports(unusedImports, f.Imports)
 
-			codeGenerated := generator.Generate(p.Name, *f, true)
-			return And(codeGenerated, func(raw []byte) Out[Empty] {
+			codeGenerated := Named("clean up imports", generator.Generate(p.Name, *f, true, opts))
+			return AndNamed("write wrappers", codeGenerated, func(raw []byte) Out[Empty] {
 				return Void(os.WriteFile(filePath, raw, 0644))
 			})
 		})
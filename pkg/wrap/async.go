@@ -1,24 +1,89 @@
 package wrap
 
-import "sync"
+import (
+	"context"
+	"sync"
+	"time"
+)
 
+// asyncOut is a future: it's settled exactly once by resolve and can be read
+// by any number of goroutines after that.
 type asyncOut[T any] struct {
-	result Out[T]
+	result   Out[T]
+	once     sync.Once
+	done     chan struct{}
+	cancel   context.CancelFunc
+	executor Executor
+}
 
-	sync.Mutex
+func newAsyncOut[T any](e Executor, cancel context.CancelFunc) *asyncOut[T] {
+	return &asyncOut[T]{done: make(chan struct{}), cancel: cancel, executor: e}
+}
 
-	resultCh chan Out[T]
+// resolve settles r with res as soon as res itself is settled, so the result of
+// a future is never another pending future. Only the first call has effect.
+func (r *asyncOut[T]) resolve(res Out[T]) {
+	if res != nil {
+		settled, ok := res.Poll()
+		if !ok {
+			if s, ok := r.executor.(Stepper); ok {
+				s.Wait(res.Done())
+				r.resolve(res)
+				return
+			}
+			go func() {
+				<-res.Done()
+				r.resolve(res)
+			}()
+			return
+		}
+		res = settled
+	}
+	r.once.Do(func() {
+		r.result = res
+		close(r.done)
+		if r.cancel != nil {
+			r.cancel()
+		}
+	})
 }
 
+// waitResult blocks until r is settled. If a Stepper is involved, the wait
+// goes through it, otherwise its tasks would never run.
 func (r *asyncOut[T]) waitResult() Out[T] {
-	res, ok := <-r.resultCh
-	if ok {
-		r.result = res
-		close(r.resultCh)
+	if s, ok := stepperOf(r); ok {
+		s.Wait(r.done)
 	}
+	<-r.done
 	return r.result
 }
 
+func (r *asyncOut[T]) executedBy() Executor {
+	return r.executor
+}
+
+// Done implements Result.
+func (r *asyncOut[T]) Done() <-chan struct{} {
+	return r.done
+}
+
+// Poll implements Result.
+func (r *asyncOut[T]) Poll() (Out[T], bool) {
+	select {
+	case <-r.done:
+		return r.result, true
+	default:
+		return nil, false
+	}
+}
+
+// Cancel implements Canceler.
+func (r *asyncOut[T]) Cancel() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
 // ErrorOrNil implements Result.
 func (r *asyncOut[T]) ErrorOrNil() error {
 	return r.waitResult().ErrorOrNil()
@@ -44,11 +109,31 @@ func (r *asyncOut[T]) IfError(onError func(error)) Out[T] {
 	return r.waitResult().IfError(onError)
 }
 
+// IfErrorIs implements Result.
+func (r *asyncOut[T]) IfErrorIs(target error, onError func(error)) Out[T] {
+	return r.waitResult().IfErrorIs(target, onError)
+}
+
 // IfOK implements Result.
 func (r *asyncOut[T]) IfOK(onOK func(T)) Out[T] {
 	return r.waitResult().IfOK(onOK)
 }
 
+// MapErr implements Result.
+func (r *asyncOut[T]) MapErr(f func(error) error) Out[T] {
+	return r.waitResult().MapErr(f)
+}
+
+// Recover implements Result.
+func (r *asyncOut[T]) Recover(f func(error) Out[T]) Out[T] {
+	return r.waitResult().Recover(f)
+}
+
+// OrElse implements Result.
+func (r *asyncOut[T]) OrElse(fallback Out[T]) Out[T] {
+	return r.waitResult().OrElse(fallback)
+}
+
 // IsError implements Result.
 func (r *asyncOut[T]) IsError() bool {
 	return r.waitResult().IsError()
@@ -59,15 +144,59 @@ func (r *asyncOut[T]) IsOK() bool {
 	return r.waitResult().IsOK()
 }
 
+// Async runs fn by DefaultExecutor.
 func Async[T any](fn func() Out[T]) Out[T] {
-	resultCh := make(chan Out[T])
-	go func() {
-		resultCh <- fn()
-	}()
-	return &asyncOut[T]{resultCh: resultCh}
+	return AsyncOn(DefaultExecutor(), fn)
+}
+
+// AsyncOn works like Async, but fn is run by the given Executor.
+// A panic inside fn turns into Err holding *PanicError, as for every async handler.
+func AsyncOn[T any](e Executor, fn func() Out[T]) Out[T] {
+	r := newAsyncOut[T](e, nil)
+	e.Go(func() {
+		r.resolve(Safe(fn))
+	})
+	return r
+}
+
+// AsyncCtx works like Async, but the result turns into Err(ctx.Err()) as soon
+// as ctx is done, without waiting for fn to return. fn receives a child of ctx
+// which is also done once the result is canceled (see Canceler) or settled, and
+// is expected to give up on its own once it's done. fn is run by the Executor
+// of ctx, see WithExecutor.
+func AsyncCtx[T any](ctx context.Context, fn func(context.Context) Out[T]) Out[T] {
+	if err := ctx.Err(); err != nil {
+		return Err[T](err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	e := ExecutorFrom(ctx)
+	r := newAsyncOut[T](e, cancel)
+	context.AfterFunc(ctx, func() {
+		r.resolve(Err[T](ctx.Err()))
+	})
+	e.Go(func() {
+		r.resolve(Safe(func() Out[T] {
+			return fn(ctx)
+		}))
+	})
+	return r
+}
+
+// Cancel cancels every result which implements Canceler.
+func Cancel(r ...ErrorContainer) {
+	for _, v := range r {
+		if c, ok := v.(Canceler); ok {
+			c.Cancel()
+		}
+	}
 }
 
 // Unwrap implements Result.
 func (r *asyncOut[T]) Unwrap() (T, error) {
 	return r.waitResult().Unwrap()
 }
+
+// TryUnwrap implements Result.
+func (r *asyncOut[T]) TryUnwrap(timeout time.Duration) (T, error) {
+	return WithTimeout[T](r, timeout).Unwrap()
+}
@@ -0,0 +1,39 @@
+package wrap
+
+import "errors"
+
+// ErrorIs reports whether the error of r matches target, see errors.Is.
+func ErrorIs(r ErrorContainer, target error) bool {
+	return errors.Is(r.ErrorOrNil(), target)
+}
+
+// Catch recovers r with f only if its error matches target, see errors.Is.
+func Catch[T any](r Out[T], target error, f func(error) Out[T]) Out[T] {
+	return r.Recover(func(err error) Out[T] {
+		if errors.Is(err, target) {
+			return f(err)
+		}
+		return Err[T](err)
+	})
+}
+
+// CatchAs recovers r with f only if its error has E in the chain, see errors.As.
+func CatchAs[E error, T any](r Out[T], f func(E) Out[T]) Out[T] {
+	return r.Recover(func(err error) Out[T] {
+		var target E
+		if errors.As(err, &target) {
+			return f(target)
+		}
+		return Err[T](err)
+	})
+}
+
+// IfErrorAs calls f only if the error of r has E in the chain, see errors.As.
+func IfErrorAs[E error, T any](r Out[T], f func(E)) Out[T] {
+	return r.IfError(func(err error) {
+		var target E
+		if errors.As(err, &target) {
+			f(target)
+		}
+	})
+}
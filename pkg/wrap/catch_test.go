@@ -0,0 +1,73 @@
+package wrap
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCatch(t *testing.T) {
+	errOther := errors.New("other error")
+	wrapped := fmt.Errorf("wrapped: %w", &IndexError{Index: 1, Err: errTest})
+	tests := []struct {
+		name string
+		r    Out[int]
+		// matches tells whether the error of r matches errTest and *IndexError.
+		matches bool
+	}{
+		{name: "ok", r: OK(1)},
+		{name: "match", r: Err[int](wrapped), matches: true},
+		{name: "match async", r: later(time.Millisecond, Err[int](wrapped)), matches: true},
+		{name: "no match", r: Err[int](errOther)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorIs(tt.r, errTest); got != tt.matches {
+				t.Fatalf("ErrorIs = %t, want %t", got, tt.matches)
+			}
+
+			called := false
+			tt.r.IfErrorIs(errTest, func(err error) {
+				called = true
+			})
+			if called != tt.matches {
+				t.Fatalf("IfErrorIs called f: %t, want %t", called, tt.matches)
+			}
+
+			var index int
+			IfErrorAs(tt.r, func(err *IndexError) {
+				index = err.Index
+			})
+			if got := index == 1; got != tt.matches {
+				t.Fatalf("IfErrorAs called f: %t, want %t", got, tt.matches)
+			}
+
+			recovered := Catch(tt.r, errTest, func(error) Out[int] {
+				return OK(2)
+			})
+			assertCaught(t, "Catch", tt.r, recovered, tt.matches)
+
+			recovered = CatchAs(tt.r, func(err *IndexError) Out[int] {
+				return OK(err.Index + 1)
+			})
+			assertCaught(t, "CatchAs", tt.r, recovered, tt.matches)
+		})
+	}
+}
+
+// assertCaught checks that recovered is OK(2) if r is caught, or the same as r otherwise.
+func assertCaught(t *testing.T, name string, r, recovered Out[int], caught bool) {
+	t.Helper()
+	got, err := recovered.Unwrap()
+	if caught {
+		if err != nil || got != 2 {
+			t.Fatalf("%s: got %v, %v, want 2", name, got, err)
+		}
+		return
+	}
+	want, wantErr := r.Unwrap()
+	if got != want || err != wantErr {
+		t.Fatalf("%s: got %v, %v, want %v, %v", name, got, err, want, wantErr)
+	}
+}
@@ -0,0 +1,82 @@
+package wrap
+
+import "context"
+
+func ctxSuccessor[T any, TT any](ctx context.Context, f Successor[T, TT]) Successor[T, TT] {
+	return func(v T) Out[TT] {
+		if err := ctx.Err(); err != nil {
+			return Err[TT](err)
+		}
+		return f(v)
+	}
+}
+
+func ProofAsyncCtx(ctx context.Context, r ...ErrorContainer) Out[Empty] {
+	return AsyncCtx(ctx, func(context.Context) Out[Empty] {
+		return Proof(r...)
+	})
+}
+
+func AndCtx[T any, TT any](ctx context.Context, r Out[T], f Successor[T, TT]) Out[TT] {
+	return andAt(callSite(), r, ctxSuccessor(ctx, f))
+}
+
+func AndAsyncCtx[T any, TT any](ctx context.Context, r Out[T], f Successor[T, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		return andAt(site, r, ctxSuccessor(ctx, f))
+	})
+}
+
+func EachCtx[T any, TT any](ctx context.Context, r []Out[T], f Successor[T, TT]) []Out[TT] {
+	return eachFunc(r, f, func(v Out[T], f Successor[T, TT]) Out[TT] {
+		return AndCtx(ctx, v, f)
+	})
+}
+
+func EachAsyncCtx[T any, TT any](ctx context.Context, r []Out[T], f Successor[T, TT]) []Out[TT] {
+	return eachFunc(r, f, func(v Out[T], f Successor[T, TT]) Out[TT] {
+		return AndAsyncCtx(ctx, v, f)
+	})
+}
+
+func SlicedAsyncCtx[T any, TT any](ctx context.Context, sliceSize int, r []Out[T], f Successor[[]T, []TT]) []Out[[]TT] {
+	return slicedFunc(sliceSize, r, f,
+		func(v Out[[]T], f Successor[[]T, []TT]) Out[[]TT] {
+			return AndAsyncCtx(ctx, v, f)
+		},
+		func(r []Out[T]) Out[[]T] {
+			return JoinAsyncCtx(ctx, r)
+		},
+	)
+}
+
+func RangeAsyncCtx[TT any](ctx context.Context, n int, f Successor[int, TT]) []Out[TT] {
+	return rangeFunc(n, f, func(v Out[int], f Successor[int, TT]) Out[TT] {
+		return AndAsyncCtx(ctx, v, f)
+	})
+}
+
+func JoinAsyncCtx[T any](ctx context.Context, r []Out[T]) Out[[]T] {
+	return AsyncCtx(ctx, func(context.Context) Out[[]T] {
+		return Join(r)
+	})
+}
+
+func ReadChanCtx[T any](ctx context.Context, ch <-chan T, onClosed func() Out[T]) Out[T] {
+	select {
+	case v, ok := <-ch:
+		if ok {
+			return OK(v)
+		}
+		return onClosed()
+	case <-ctx.Done():
+		return Err[T](ctx.Err())
+	}
+}
+
+func ReadChanAsyncCtx[T any](ctx context.Context, ch <-chan T, onClosed func() Out[T]) Out[T] {
+	return AsyncCtx(ctx, func(ctx context.Context) Out[T] {
+		return ReadChanCtx(ctx, ch, onClosed)
+	})
+}
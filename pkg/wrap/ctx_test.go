@@ -0,0 +1,113 @@
+package wrap
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAsyncCtx(t *testing.T) {
+	t.Run("cancel settles a pending result", func(t *testing.T) {
+		block := make(chan struct{})
+		t.Cleanup(func() {
+			close(block)
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		r := AsyncCtx(ctx, func(context.Context) Out[int] {
+			<-block
+			return OK(1)
+		})
+		cancel()
+
+		select {
+		case <-r.Done():
+		case <-time.After(time.Second):
+			t.Fatal("result isn't settled after cancel")
+		}
+		if err := r.ErrorOrNil(); !errors.Is(err, context.Canceled) {
+			t.Fatalf("got error %v, want %v", err, context.Canceled)
+		}
+	})
+	t.Run("cancelled ctx", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		called := false
+		r := AsyncCtx(ctx, func(context.Context) Out[int] {
+			called = true
+			return OK(1)
+		})
+
+		if _, ok := r.Poll(); !ok {
+			t.Fatal("result isn't settled")
+		}
+		if err := r.ErrorOrNil(); !errors.Is(err, context.Canceled) {
+			t.Fatalf("got error %v, want %v", err, context.Canceled)
+		}
+		if called {
+			t.Fatal("fn is called")
+		}
+	})
+}
+
+func TestAsyncCtxSuccessors(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(ctx context.Context, f Successor[int, int]) []Out[int]
+	}{
+		{
+			name: "and",
+			run: func(ctx context.Context, f Successor[int, int]) []Out[int] {
+				return []Out[int]{AndAsyncCtx(ctx, OK(1), f)}
+			},
+		},
+		{
+			name: "each",
+			run: func(ctx context.Context, f Successor[int, int]) []Out[int] {
+				return EachAsyncCtx(ctx, []Out[int]{OK(1), OK(2), OK(3)}, f)
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, cancelled := range []bool{false, true} {
+			tt, cancelled := tt, cancelled
+			name := tt.name
+			if cancelled {
+				name += " cancelled"
+			}
+			t.Run(name, func(t *testing.T) {
+				// The scheduler holds the tasks back until the ctx is cancelled.
+				s := NewScheduler()
+				ctx, cancel := context.WithCancel(WithExecutor(context.Background(), s))
+				defer cancel()
+				var calls atomic.Int32
+				r := tt.run(ctx, func(v int) Out[int] {
+					calls.Add(1)
+					return OK(v)
+				})
+				if cancelled {
+					cancel()
+				}
+				s.Run()
+
+				for _, v := range r {
+					err := v.ErrorOrNil()
+					if cancelled && !errors.Is(err, context.Canceled) {
+						t.Fatalf("got error %v, want %v", err, context.Canceled)
+					}
+					if !cancelled && err != nil {
+						t.Fatalf("got error %v", err)
+					}
+				}
+				want := int32(len(r))
+				if cancelled {
+					want = 0
+				}
+				if got := calls.Load(); got != want {
+					t.Fatalf("f is called %d times, want %d", got, want)
+				}
+			})
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package wrap
 
+import (
+	"errors"
+	"time"
+)
+
 type err[T any] struct {
 	err error
 }
@@ -34,10 +39,34 @@ func (f err[T]) IfError(onError func(error)) Out[T] {
 	return f
 }
 
+func (f err[T]) IfErrorIs(target error, onError func(error)) Out[T] {
+	if errors.Is(f.err, target) {
+		onError(f.err)
+	}
+	return f
+}
+
 func (e err[T]) IfOK(onOK func(T)) Out[T] {
 	return e
 }
 
+// MapErr keeps the original error if f returns nil, use Recover to turn Err
+// into OK.
+func (e err[T]) MapErr(f func(error) error) Out[T] {
+	if mapped := f(e.err); mapped != nil {
+		return Err[T](mapped)
+	}
+	return e
+}
+
+func (e err[T]) Recover(f func(error) Out[T]) Out[T] {
+	return f(e.err)
+}
+
+func (err[T]) OrElse(fallback Out[T]) Out[T] {
+	return fallback
+}
+
 func Err[T any](e error) Out[T] {
 	output := new(err[T])
 	output.err = e
@@ -49,3 +78,15 @@ func (e err[T]) Unwrap() (T, error) {
 	var zeroVal T
 	return zeroVal, e.err
 }
+
+func (e err[T]) TryUnwrap(time.Duration) (T, error) {
+	return e.Unwrap()
+}
+
+func (err[T]) Done() <-chan struct{} {
+	return closedCh
+}
+
+func (e err[T]) Poll() (Out[T], bool) {
+	return e, true
+}
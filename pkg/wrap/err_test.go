@@ -0,0 +1,61 @@
+package wrap
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMapErr(t *testing.T) {
+	errMapped := errors.New("mapped")
+	tests := []struct {
+		name    string
+		r       Out[int]
+		f       func(error) error
+		wantErr error
+	}{
+		{
+			name: "mapped",
+			r:    Err[int](errTest),
+			f: func(error) error {
+				return errMapped
+			},
+			wantErr: errMapped,
+		},
+		{
+			name: "nil keeps the original error",
+			r:    Err[int](errTest),
+			f: func(error) error {
+				return nil
+			},
+			wantErr: errTest,
+		},
+		{
+			name: "async",
+			r: Async(func() Out[int] {
+				return Err[int](errTest)
+			}),
+			f: func(error) error {
+				return nil
+			},
+			wantErr: errTest,
+		},
+		{
+			name: "ok",
+			r:    OK(1),
+			f: func(error) error {
+				return errMapped
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.r.MapErr(tt.f)
+			if err := res.ErrorOrNil(); err != tt.wantErr {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if res.IsError() != (tt.wantErr != nil) {
+				t.Fatalf("got IsError %v, want %v", res.IsError(), tt.wantErr != nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package wrap
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	// Executor decides where and when the tasks behind async results run.
+	Executor interface {
+		Go(task func())
+	}
+
+	// Stepper is implemented by executors which run tasks only when asked to.
+	// Waiting for a result produced by such executor goes through Wait, so its
+	// tasks run instead of blocking.
+	Stepper interface {
+		Executor
+		// Step runs one ready task, false if there are none.
+		Step() bool
+		// Wait returns once any of done is closed, running tasks meanwhile.
+		Wait(done ...<-chan struct{})
+	}
+
+	goExecutor struct{}
+
+	inlineExecutor struct{}
+
+	pool struct {
+		sync.Mutex
+
+		limit   int
+		running int
+		queue   []func()
+	}
+
+	// Scheduler is a deterministic Executor for tests: it runs one task at a
+	// time and only when Run, Step or a wait for a result asks for it. A task
+	// which waits for a result is parked until the result is settled, so the
+	// rest of them run meanwhile. Tasks must not block on anything else (e.g. on
+	// channels written by other tasks) as no other task runs until they return.
+	// While a task runs, no other goroutine may wait for the results of s (or
+	// for any result if s is the DefaultExecutor), as Wait takes every such
+	// call for one made by the running task.
+	Scheduler struct {
+		sync.Mutex
+
+		queue  []*schedulerTask
+		parked []*schedulerTask
+		pick   func(ready int) int
+		// running is the task which runs now, nil if none. Wait called while
+		// it's set comes from that task, as the driver is blocked in Step.
+		running *schedulerTask
+		yield   chan struct{}
+		wake    chan struct{}
+		drive   sync.Mutex
+	}
+
+	schedulerTask struct {
+		fn      func()
+		waitFor []<-chan struct{}
+		resume  chan struct{}
+	}
+
+	// executed is implemented by results which know their Executor.
+	executed interface {
+		executedBy() Executor
+	}
+
+	executorHolder struct {
+		Executor
+	}
+
+	executorCtxKey struct{}
+)
+
+var (
+	// GoExecutor starts a new goroutine per task.
+	GoExecutor Executor = goExecutor{}
+	// InlineExecutor runs a task right away on the calling goroutine, which makes
+	// every async handler synchronous (handy for debugging).
+	InlineExecutor Executor = inlineExecutor{}
+
+	defaultExecutor atomic.Value
+)
+
+func init() {
+	defaultExecutor.Store(executorHolder{GoExecutor})
+}
+
+// DefaultExecutor is used by Async and so by every **Async handler,
+// GoExecutor unless it's replaced by SetDefaultExecutor.
+func DefaultExecutor() Executor {
+	return defaultExecutor.Load().(executorHolder).Executor
+}
+
+// SetDefaultExecutor replaces DefaultExecutor and returns the previous one.
+func SetDefaultExecutor(e Executor) Executor {
+	return defaultExecutor.Swap(executorHolder{e}).(executorHolder).Executor
+}
+
+// WithExecutor returns ctx which makes AsyncCtx and the **AsyncCtx handlers
+// use e.
+func WithExecutor(ctx context.Context, e Executor) context.Context {
+	return context.WithValue(ctx, executorCtxKey{}, e)
+}
+
+// ExecutorFrom returns the Executor set by WithExecutor, DefaultExecutor if none.
+func ExecutorFrom(ctx context.Context) Executor {
+	if e, ok := ctx.Value(executorCtxKey{}).(Executor); ok {
+		return e
+	}
+	return DefaultExecutor()
+}
+
+// stepperOf returns the Stepper which produces any of r, or DefaultExecutor if
+// it's a Stepper, as the caller may be its task then.
+func stepperOf(r ...ErrorContainer) (Stepper, bool) {
+	for _, v := range r {
+		if v, ok := v.(executed); ok {
+			if s, ok := v.executedBy().(Stepper); ok {
+				return s, true
+			}
+		}
+	}
+	s, ok := DefaultExecutor().(Stepper)
+	return s, ok
+}
+
+func (goExecutor) Go(task func()) {
+	go task()
+}
+
+func (inlineExecutor) Go(task func()) {
+	task()
+}
+
+// NewPool returns an Executor which runs at most limit tasks at once, the rest
+// of them wait in a queue. Workers are started on demand and exit once the
+// queue is drained, so the pool doesn't need to be closed.
+func NewPool(limit int) Executor {
+	if limit < 1 {
+		limit = 1
+	}
+	return &pool{limit: limit}
+}
+
+func (p *pool) Go(task func()) {
+	p.Lock()
+	if p.running < p.limit {
+		p.running++
+		p.Unlock()
+		go p.work(task)
+		return
+	}
+	p.queue = append(p.queue, task)
+	p.Unlock()
+}
+
+func (p *pool) work(task func()) {
+	for task != nil {
+		task()
+		p.Lock()
+		task = nil
+		if len(p.queue) > 0 {
+			task = p.queue[0]
+			p.queue[0] = nil
+			p.queue = p.queue[1:]
+		} else {
+			p.running--
+		}
+		p.Unlock()
+	}
+}
+
+// NewScheduler returns a Scheduler which runs tasks in the order they became
+// ready.
+func NewScheduler() *Scheduler {
+	return NewSchedulerFunc(func(int) int {
+		return 0
+	})
+}
+
+// NewSchedulerFunc returns a Scheduler which runs the ready task with the
+// index given by pick. Parked tasks which can be resumed go first in the order
+// they were parked, then new tasks in the order they were queued.
+func NewSchedulerFunc(pick func(ready int) int) *Scheduler {
+	return &Scheduler{
+		pick:  pick,
+		yield: make(chan struct{}),
+		wake:  make(chan struct{}, 1),
+	}
+}
+
+func (s *Scheduler) Go(task func()) {
+	s.Lock()
+	s.queue = append(s.queue, &schedulerTask{fn: task})
+	s.Unlock()
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Step runs one ready task until it returns or waits for a result.
+func (s *Scheduler) Step() bool {
+	s.drive.Lock()
+	defer s.drive.Unlock()
+	s.Lock()
+	ready := []*schedulerTask{}
+	for _, v := range s.parked {
+		if anyClosed(v.waitFor) {
+			ready = append(ready, v)
+		}
+	}
+	ready = append(ready, s.queue...)
+	if len(ready) == 0 {
+		s.Unlock()
+		return false
+	}
+	task := ready[s.pick(len(ready))]
+	s.parked = without(s.parked, task)
+	s.queue = without(s.queue, task)
+	s.running = task
+	s.Unlock()
+	if task.resume != nil {
+		task.resume <- struct{}{}
+	} else {
+		go s.run(task)
+	}
+	<-s.yield
+	return true
+}
+
+func (s *Scheduler) run(task *schedulerTask) {
+	defer func() {
+		s.Lock()
+		s.running = nil
+		s.Unlock()
+		s.yield <- struct{}{}
+	}()
+	task.fn()
+}
+
+// Wait parks the task which calls it until any of done is closed. Called
+// outside of the tasks of s, it runs the ready ones until then.
+func (s *Scheduler) Wait(done ...<-chan struct{}) {
+	if anyClosed(done) {
+		return
+	}
+	s.Lock()
+	task := s.running
+	if task == nil {
+		s.Unlock()
+		for !anyClosed(done) {
+			if !s.Step() {
+				s.block(done)
+			}
+		}
+		return
+	}
+	task.waitFor = done
+	if task.resume == nil {
+		task.resume = make(chan struct{})
+	}
+	s.parked = append(s.parked, task)
+	s.running = nil
+	s.Unlock()
+	s.yield <- struct{}{}
+	<-task.resume
+}
+
+// block waits until any of done is closed or a task may have become ready
+// because of something which happened outside of s.
+func (s *Scheduler) block(done []<-chan struct{}) {
+	s.Lock()
+	cases := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(s.wake)}}
+	for _, ch := range done {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)})
+	}
+	for _, v := range s.parked {
+		for _, ch := range v.waitFor {
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)})
+		}
+	}
+	s.Unlock()
+	reflect.Select(cases)
+}
+
+// Run runs the ready tasks, including the ones which become ready meanwhile,
+// until none are left.
+func (s *Scheduler) Run() {
+	for s.Step() {
+	}
+}
+
+func anyClosed(done []<-chan struct{}) bool {
+	for _, ch := range done {
+		select {
+		case <-ch:
+			return true
+		default:
+		}
+	}
+	return false
+}
+
+func without(tasks []*schedulerTask, task *schedulerTask) []*schedulerTask {
+	for i, v := range tasks {
+		if v == task {
+			return append(tasks[:i], tasks[i+1:]...)
+		}
+	}
+	return tasks
+}
@@ -0,0 +1,87 @@
+package wrap
+
+import (
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// gauge tracks how many calls of f run at once.
+type gauge struct {
+	now, max atomic.Int32
+}
+
+func (g *gauge) successor(v int) Out[int] {
+	n := g.now.Add(1)
+	defer g.now.Add(-1)
+	for {
+		m := g.max.Load()
+		if n <= m || g.max.CompareAndSwap(m, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	return OK(v)
+}
+
+func TestAsyncNLimit(t *testing.T) {
+	const limit, n = 3, 12
+	tests := []struct {
+		name string
+		run  func(g *gauge) ErrorContainer
+	}{
+		{
+			name: "each",
+			run: func(g *gauge) ErrorContainer {
+				return Proof(containers(EachAsyncN(limit, Range(n, OK[int]), g.successor))...)
+			},
+		},
+		{
+			name: "range",
+			run: func(g *gauge) ErrorContainer {
+				return Proof(containers(RangeAsyncN(limit, n, g.successor))...)
+			},
+		},
+		{
+			name: "sliced",
+			run: func(g *gauge) ErrorContainer {
+				return Proof(containers(SlicedAsyncN(limit, 1, Range(n, OK[int]), func(v []int) Out[[]int] {
+					return Map(g.successor(v[0]), func(v int) []int {
+						return []int{v}
+					})
+				}))...)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g gauge
+			if err := tt.run(&g).ErrorOrNil(); err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if got := g.max.Load(); got != limit {
+				t.Fatalf("%d calls ran at once, want %d", got, limit)
+			}
+		})
+	}
+}
+
+func TestSlicedAsyncNOneWorker(t *testing.T) {
+	r := SlicedAsyncN(1, 2, RangeAsync(5, OK[int]), func(v []int) Out[[]int] {
+		return OK(v)
+	})
+	all := Join(r)
+	select {
+	case <-all.Done():
+	case <-time.After(time.Second):
+		t.Fatal("deadlock")
+	}
+	got, err := all.Unwrap()
+	if err != nil {
+		t.Fatalf("got error %v", err)
+	}
+	if want := [][]int{{0, 1}, {2, 3}, {4}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
@@ -1,25 +1,18 @@
+//go:generate go run ../../internal/xngen -out=xn.gen.go -test-out=xn.gen_test.go -max=16 -max-tuple=9
 package wrap
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
 	ErrChanClosed = errors.New("channel closed")
 	ErrNotFound   = errors.New("unable to find first, condition not met")
+	ErrTimeout    = errors.New("timed out")
 )
 
-type (
-	Successor[T1, TT any]                                   func(T1) Out[TT]
-	SuccessorX2[T1, T2, TT any]                             func(T1, T2) Out[TT]
-	SuccessorX3[T1, T2, T3, TT any]                         func(T1, T2, T3) Out[TT]
-	SuccessorX4[T1, T2, T3, T4, TT any]                     func(T1, T2, T3, T4) Out[TT]
-	SuccessorX5[T1, T2, T3, T4, T5, TT any]                 func(T1, T2, T3, T4, T5) Out[TT]
-	SuccessorX6[T1, T2, T3, T4, T5, T6, TT any]             func(T1, T2, T3, T4, T5, T6) Out[TT]
-	SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT any]         func(T1, T2, T3, T4, T5, T6, T7) Out[TT]
-	SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT any]     func(T1, T2, T3, T4, T5, T6, T7, T8) Out[TT]
-	SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any] func(T1, T2, T3, T4, T5, T6, T7, T8, T9) Out[TT]
-)
+type Successor[T1, TT any] func(T1) Out[TT]
 
 func Proof(r ...ErrorContainer) Out[Empty] {
 	for _, v := range r {
@@ -36,25 +29,158 @@ func ProofAsync(r ...ErrorContainer) Out[Empty] {
 	})
 }
 
-func first[T any](r []Out[T], test func(Out[T]) bool) Out[T] {
-	length := len(r)
-	chans := make(chan Out[T], length)
+func containers[T any](r []Out[T]) []ErrorContainer {
+	res := make([]ErrorContainer, 0, len(r))
 	for _, v := range r {
-		v := v
+		res = append(res, v)
+	}
+	return res
+}
+
+// eachSettled calls fn with the index of every result once it's settled, in
+// the order they settle, until fn returns false. The results which are settled
+// already go first in the index order, the rest are watched by a goroutine each,
+// all of them are stopped as soon as fn returns false.
+func eachSettled(r []ErrorContainer, fn func(i int) bool) {
+	if s, ok := stepperOf(r...); ok {
+		eachSettledBy(s, r, fn)
+		return
+	}
+	settled := make(chan int, len(r))
+	stop := make(chan struct{})
+	defer close(stop)
+	pending := 0
+	for i, v := range r {
+		select {
+		case <-v.Done():
+			if !fn(i) {
+				return
+			}
+			continue
+		default:
+		}
+		i, v := i, v
+		pending++
 		go func() {
-			_ = v.IsOK()
-			chans <- v
+			select {
+			case <-v.Done():
+				settled <- i
+			case <-stop:
+			}
 		}()
 	}
-	for v := range chans {
-		if test(v) {
-			return v
+	for ; pending > 0; pending-- {
+		if !fn(<-settled) {
+			return
 		}
-		if length == 0 {
-			break
+	}
+}
+
+func firstError(r []ErrorContainer) error {
+	var err error
+	eachSettled(r, func(i int) bool {
+		err = r[i].ErrorOrNil()
+		return err == nil
+	})
+	if err != nil {
+		Cancel(r...)
+	}
+	return err
+}
+
+// ProofFailFast works like Proof, but waits for all the results at once and
+// returns the first error to finish. The rest of the results are canceled then,
+// which stops only the ones implementing Canceler (e.g. made by AsyncCtx),
+// others keep running, but aren't waited for.
+func ProofFailFast(r ...ErrorContainer) Out[Empty] {
+	return Void(firstError(r))
+}
+
+func ProofFailFastAsync(r ...ErrorContainer) Out[Empty] {
+	return Async(func() Out[Empty] {
+		return ProofFailFast(r...)
+	})
+}
+
+// IndexError tells which element (or argument) of a handler produced Err,
+// Index starts from 0.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("#%d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+func allErrors(r []ErrorContainer) error {
+	errs := []error{}
+	for i, v := range r {
+		if err := v.ErrorOrNil(); err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
 		}
 	}
-	return Err[T](ErrNotFound)
+	return errors.Join(errs...)
+}
+
+// ProofAll works like Proof, but collects every error instead of the first one.
+// The errors are joined with errors.Join, each one wrapped into *IndexError.
+func ProofAll(r ...ErrorContainer) Out[Empty] {
+	return Void(allErrors(r))
+}
+
+func ProofAllAsync(r ...ErrorContainer) Out[Empty] {
+	return Async(func() Out[Empty] {
+		return ProofAll(r...)
+	})
+}
+
+// eachSettledBy works like eachSettled, but waits through s and checks the
+// results in the index order, so the order doesn't depend on goroutines.
+func eachSettledBy(s Stepper, r []ErrorContainer, fn func(i int) bool) {
+	seen := make([]bool, len(r))
+	for {
+		pending := []<-chan struct{}{}
+		for i, v := range r {
+			if seen[i] {
+				continue
+			}
+			select {
+			case <-v.Done():
+				seen[i] = true
+				if !fn(i) {
+					return
+				}
+			default:
+				pending = append(pending, v.Done())
+			}
+		}
+		if len(pending) == 0 {
+			return
+		}
+		s.Wait(pending...)
+	}
+}
+
+// first returns the first result to settle which passes test, or
+// Err(ErrNotFound) once all of them are settled and none passed.
+func first[T any](r []Out[T], test func(Out[T]) bool) Out[T] {
+	found := -1
+	eachSettled(containers(r), func(i int) bool {
+		if test(r[i]) {
+			found = i
+			return false
+		}
+		return true
+	})
+	if found < 0 {
+		return Err[T](ErrNotFound)
+	}
+	return r[found]
 }
 
 func FirstOK[T any](r []Out[T]) Out[T] {
@@ -105,6 +231,17 @@ func EachAsync[T any, TT any](r []Out[T], f Successor[T, TT]) []Out[TT] {
 	return eachFunc(r, f, AndAsync)
 }
 
+func EachAsyncOn[T any, TT any](e Executor, r []Out[T], f Successor[T, TT]) []Out[TT] {
+	return eachFunc(r, f, func(v Out[T], f Successor[T, TT]) Out[TT] {
+		return AndAsyncOn(e, v, f)
+	})
+}
+
+// EachAsyncN works like EachAsync, but runs at most limit successors at once.
+func EachAsyncN[T any, TT any](limit int, r []Out[T], f Successor[T, TT]) []Out[TT] {
+	return EachAsyncOn(NewPool(limit), r, f)
+}
+
 func slicedFunc[T any, TT any](
 	sliceSize int,
 	r []Out[T],
@@ -131,6 +268,21 @@ func SlicedAsync[T any, TT any](sliceSize int, r []Out[T], f Successor[[]T, []TT
 	return slicedFunc(sliceSize, r, f, AndAsync, JoinAsync)
 }
 
+func SlicedAsyncOn[T any, TT any](e Executor, sliceSize int, r []Out[T], f Successor[[]T, []TT]) []Out[[]TT] {
+	return slicedFunc(sliceSize, r, f, func(v Out[[]T], f Successor[[]T, []TT]) Out[[]TT] {
+		return AndAsyncOn(e, v, f)
+	}, func(r []Out[T]) Out[[]T] {
+		return AsyncOn(e, func() Out[[]T] {
+			return Join(r)
+		})
+	})
+}
+
+// SlicedAsyncN works like SlicedAsync, but runs at most limit successors at once.
+func SlicedAsyncN[T any, TT any](limit int, sliceSize int, r []Out[T], f Successor[[]T, []TT]) []Out[[]TT] {
+	return SlicedAsyncOn(NewPool(limit), sliceSize, r, f)
+}
+
 func rangeFunc[TT any](n int, f Successor[int, TT], and func(Out[int], Successor[int, TT]) Out[TT]) []Out[TT] {
 	res := make([]Out[TT], 0, n)
 	for i := 0; i < n; i++ {
@@ -147,6 +299,17 @@ func RangeAsync[TT any](n int, f Successor[int, TT]) []Out[TT] {
 	return rangeFunc(n, f, AndAsync)
 }
 
+func RangeAsyncOn[TT any](e Executor, n int, f Successor[int, TT]) []Out[TT] {
+	return rangeFunc(n, f, func(v Out[int], f Successor[int, TT]) Out[TT] {
+		return AndAsyncOn(e, v, f)
+	})
+}
+
+// RangeAsyncN works like RangeAsync, but runs at most limit successors at once.
+func RangeAsyncN[TT any](limit int, n int, f Successor[int, TT]) []Out[TT] {
+	return RangeAsyncOn(NewPool(limit), n, f)
+}
+
 func Join[T any](r []Out[T]) Out[[]T] {
 	res := make([]T, 0, len(r))
 	for _, v := range r {
@@ -165,6 +328,37 @@ func JoinAsync[T any](r []Out[T]) Out[[]T] {
 	})
 }
 
+// JoinFailFast works like Join, but waits for all the results at once and
+// returns the first error to finish. The rest of the results are canceled then,
+// see ProofFailFast.
+func JoinFailFast[T any](r []Out[T]) Out[[]T] {
+	if err := firstError(containers(r)); err != nil {
+		return Err[[]T](err)
+	}
+	return Join(r)
+}
+
+func JoinFailFastAsync[T any](r []Out[T]) Out[[]T] {
+	return Async(func() Out[[]T] {
+		return JoinFailFast(r)
+	})
+}
+
+// JoinAll works like Join, but collects every error instead of the first one.
+// The errors are joined with errors.Join, each one wrapped into *IndexError.
+func JoinAll[T any](r []Out[T]) Out[[]T] {
+	if err := allErrors(containers(r)); err != nil {
+		return Err[[]T](err)
+	}
+	return Join(r)
+}
+
+func JoinAllAsync[T any](r []Out[T]) Out[[]T] {
+	return Async(func() Out[[]T] {
+		return JoinAll(r)
+	})
+}
+
 func Just[T any](isError bool, r []Out[T]) []Out[T] {
 	res := []Out[T]{}
 	for _, v := range r {
@@ -210,6 +404,15 @@ func Wrap[T any](val T, err error) Out[T] {
 	return OK(val)
 }
 
+// WrapOK turns comma-ok results (map lookups and alike) into Out, it's
+// Err(ErrNotFound) if ok is false.
+func WrapOK[T any](val T, ok bool) Out[T] {
+	if !ok {
+		return Err[T](ErrNotFound)
+	}
+	return OK(val)
+}
+
 func Void(err error) Out[Empty] {
 	return Wrap(Empty{}, err)
 }
@@ -231,207 +434,45 @@ func Flat[T any](r []Out[[]T]) []Out[T] {
 }
 
 func And[T any, TT any](r Out[T], f Successor[T, TT]) Out[TT] {
+	return andAt(callSite(), r, f)
+}
+
+// andAt is And which labels errors produced by f with the site, see SetDebug.
+func andAt[T any, TT any](site string, r Out[T], f Successor[T, TT]) Out[TT] {
 	if r.IsOK() {
 		var defaultV T
-		return f(r.GetOrDefault(defaultV))
+		res := f(r.GetOrDefault(defaultV))
+		if site != "" {
+			return step(res, "", -1, site)
+		}
+		return res
 	}
 	return Err[TT](r.ErrorOrNil())
 }
 
 func AndAsync[T any, TT any](r Out[T], f Successor[T, TT]) Out[TT] {
+	site := callSite()
 	return Async(func() Out[TT] {
-		return And(r, f)
-	})
-}
-
-func AndX2[T1, T2, TT any](r1 Out[T1], r2 Out[T2], f SuccessorX2[T1, T2, TT]) Out[TT] {
-	allGood := Proof(r1, r2)
-	return And(allGood, func(Empty) Out[TT] {
-		var defaultV1 T1
-		var defaultV2 T2
-		return f(r1.GetOrDefault(defaultV1),
-			r2.GetOrDefault(defaultV2),
-		)
-	})
-}
-
-func AndX2Async[T1, T2, TT any](r1 Out[T1], r2 Out[T2], f SuccessorX2[T1, T2, TT]) Out[TT] {
-	return Async(func() Out[TT] {
-		return AndX2(r1, r2, f)
-	})
-}
-
-func AndX3[T1, T2, T3, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], f SuccessorX3[T1, T2, T3, TT]) Out[TT] {
-	allGood := Proof(r1, r2)
-	return And(allGood, func(Empty) Out[TT] {
-		var defaultV1 T1
-		var defaultV2 T2
-		var defaultV3 T3
-		return f(r1.GetOrDefault(defaultV1),
-			r2.GetOrDefault(defaultV2),
-			r3.GetOrDefault(defaultV3),
-		)
-	})
-}
-
-func AndX3Async[T1, T2, T3, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], f SuccessorX3[T1, T2, T3, TT]) Out[TT] {
-	return Async(func() Out[TT] {
-		return AndX3(r1, r2, r3, f)
+		return andAt(site, r, f)
 	})
 }
 
-func AndX4[T1, T2, T3, T4, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], f SuccessorX4[T1, T2, T3, T4, TT]) Out[TT] {
-	allGood := Proof(r1, r2)
-	return And(allGood, func(Empty) Out[TT] {
-		var defaultV1 T1
-		var defaultV2 T2
-		var defaultV3 T3
-		var defaultV4 T4
-		return f(r1.GetOrDefault(defaultV1),
-			r2.GetOrDefault(defaultV2),
-			r3.GetOrDefault(defaultV3),
-			r4.GetOrDefault(defaultV4),
-		)
-	})
-}
-
-func AndX4Async[T1, T2, T3, T4, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], f SuccessorX4[T1, T2, T3, T4, TT]) Out[TT] {
-	return Async(func() Out[TT] {
-		return AndX4(r1, r2, r3, r4, f)
-	})
-}
-
-func AndX5[T1, T2, T3, T4, T5, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], f SuccessorX5[T1, T2, T3, T4, T5, TT]) Out[TT] {
-	allGood := Proof(r1, r2)
-	return And(allGood, func(Empty) Out[TT] {
-		var defaultV1 T1
-		var defaultV2 T2
-		var defaultV3 T3
-		var defaultV4 T4
-		var defaultV5 T5
-		return f(r1.GetOrDefault(defaultV1),
-			r2.GetOrDefault(defaultV2),
-			r3.GetOrDefault(defaultV3),
-			r4.GetOrDefault(defaultV4),
-			r5.GetOrDefault(defaultV5),
-		)
-	})
-}
-
-func AndX5Async[T1, T2, T3, T4, T5, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], f SuccessorX5[T1, T2, T3, T4, T5, TT]) Out[TT] {
-	return Async(func() Out[TT] {
-		return AndX5(r1, r2, r3, r4, r5, f)
-	})
-}
-
-func AndX6[T1, T2, T3, T4, T5, T6, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], f SuccessorX6[T1, T2, T3, T4, T5, T6, TT]) Out[TT] {
-	allGood := Proof(r1, r2)
-	return And(allGood, func(Empty) Out[TT] {
-		var defaultV1 T1
-		var defaultV2 T2
-		var defaultV3 T3
-		var defaultV4 T4
-		var defaultV5 T5
-		var defaultV6 T6
-		return f(r1.GetOrDefault(defaultV1),
-			r2.GetOrDefault(defaultV2),
-			r3.GetOrDefault(defaultV3),
-			r4.GetOrDefault(defaultV4),
-			r5.GetOrDefault(defaultV5),
-			r6.GetOrDefault(defaultV6),
-		)
-	})
-}
-
-func AndX6Async[T1, T2, T3, T4, T5, T6, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], f SuccessorX6[T1, T2, T3, T4, T5, T6, TT]) Out[TT] {
-	return Async(func() Out[TT] {
-		return AndX6(r1, r2, r3, r4, r5, r6, f)
-	})
-}
-
-func AndX7[T1, T2, T3, T4, T5, T6, T7, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], f SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT]) Out[TT] {
-	allGood := Proof(r1, r2)
-	return And(allGood, func(Empty) Out[TT] {
-		var defaultV1 T1
-		var defaultV2 T2
-		var defaultV3 T3
-		var defaultV4 T4
-		var defaultV5 T5
-		var defaultV6 T6
-		var defaultV7 T7
-		return f(r1.GetOrDefault(defaultV1),
-			r2.GetOrDefault(defaultV2),
-			r3.GetOrDefault(defaultV3),
-			r4.GetOrDefault(defaultV4),
-			r5.GetOrDefault(defaultV5),
-			r6.GetOrDefault(defaultV6),
-			r7.GetOrDefault(defaultV7),
-		)
-	})
-}
-
-func AndX7Async[T1, T2, T3, T4, T5, T6, T7, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], f SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT]) Out[TT] {
-	return Async(func() Out[TT] {
-		return AndX7(r1, r2, r3, r4, r5, r6, r7, f)
-	})
-}
-
-func AndX8[T1, T2, T3, T4, T5, T6, T7, T8, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], f SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT]) Out[TT] {
-	allGood := Proof(r1, r2)
-	return And(allGood, func(Empty) Out[TT] {
-		var defaultV1 T1
-		var defaultV2 T2
-		var defaultV3 T3
-		var defaultV4 T4
-		var defaultV5 T5
-		var defaultV6 T6
-		var defaultV7 T7
-		var defaultV8 T8
-		return f(r1.GetOrDefault(defaultV1),
-			r2.GetOrDefault(defaultV2),
-			r3.GetOrDefault(defaultV3),
-			r4.GetOrDefault(defaultV4),
-			r5.GetOrDefault(defaultV5),
-			r6.GetOrDefault(defaultV6),
-			r7.GetOrDefault(defaultV7),
-			r8.GetOrDefault(defaultV8),
-		)
-	})
-}
-
-func AndX8Async[T1, T2, T3, T4, T5, T6, T7, T8, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], f SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT]) Out[TT] {
-	return Async(func() Out[TT] {
-		return AndX8(r1, r2, r3, r4, r5, r6, r7, r8, f)
+func AndAsyncOn[T any, TT any](e Executor, r Out[T], f Successor[T, TT]) Out[TT] {
+	site := callSite()
+	return AsyncOn(e, func() Out[TT] {
+		return andAt(site, r, f)
 	})
 }
 
-func AndX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], f SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT]) Out[TT] {
-	allGood := Proof(r1, r2)
-	return And(allGood, func(Empty) Out[TT] {
-		var defaultV1 T1
-		var defaultV2 T2
-		var defaultV3 T3
-		var defaultV4 T4
-		var defaultV5 T5
-		var defaultV6 T6
-		var defaultV7 T7
-		var defaultV8 T8
-		var defaultV9 T9
-		return f(r1.GetOrDefault(defaultV1),
-			r2.GetOrDefault(defaultV2),
-			r3.GetOrDefault(defaultV3),
-			r4.GetOrDefault(defaultV4),
-			r5.GetOrDefault(defaultV5),
-			r6.GetOrDefault(defaultV6),
-			r7.GetOrDefault(defaultV7),
-			r8.GetOrDefault(defaultV8),
-			r9.GetOrDefault(defaultV9),
-		)
+// Map is And for successors which can't fail.
+func Map[T any, TT any](r Out[T], f func(T) TT) Out[TT] {
+	return And(r, func(v T) Out[TT] {
+		return OK(f(v))
 	})
 }
 
-func AndX9Async[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], f SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT]) Out[TT] {
+func MapAsync[T any, TT any](r Out[T], f func(T) TT) Out[TT] {
 	return Async(func() Out[TT] {
-		return AndX9(r1, r2, r3, r4, r5, r6, r7, r8, r9, f)
+		return Map(r, f)
 	})
 }
@@ -0,0 +1,172 @@
+package wrap
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+var errTest = errors.New("test error")
+
+// never returns a result which isn't settled until the test is over.
+func never[T any](t *testing.T) Out[T] {
+	t.Helper()
+	block := make(chan struct{})
+	t.Cleanup(func() {
+		close(block)
+	})
+	return AsyncOn(GoExecutor, func() Out[T] {
+		<-block
+		return Err[T](errTest)
+	})
+}
+
+func later[T any](d time.Duration, r Out[T]) Out[T] {
+	return AsyncOn(GoExecutor, func() Out[T] {
+		time.Sleep(d)
+		return r
+	})
+}
+
+// requireGoroutines waits for the number of goroutines to drop to want.
+func requireGoroutines(t *testing.T, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > want {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines left, want %d", runtime.NumGoroutine(), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFirstOK(t *testing.T) {
+	tests := []struct {
+		name    string
+		results func(t *testing.T) []Out[int]
+		want    int
+		wantErr error
+		// leftover is the number of goroutines which are expected to stay,
+		// i.e. the producers of the results which never settle.
+		leftover int
+	}{
+		{
+			name: "match",
+			results: func(t *testing.T) []Out[int] {
+				return []Out[int]{Err[int](errTest), later(time.Millisecond, OK(1)), OK(2)}
+			},
+			want: 2,
+		},
+		{
+			name: "no match",
+			results: func(t *testing.T) []Out[int] {
+				return []Out[int]{Err[int](errTest), later(time.Millisecond, Err[int](errTest))}
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "match while others never settle",
+			results: func(t *testing.T) []Out[int] {
+				return []Out[int]{never[int](t), later(time.Millisecond, OK(1)), never[int](t)}
+			},
+			want:     1,
+			leftover: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base := runtime.NumGoroutine()
+			v, err := FirstOK(tt.results(t)).Unwrap()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if v != tt.want {
+				t.Fatalf("got %v, want %v", v, tt.want)
+			}
+			requireGoroutines(t, base+tt.leftover)
+		})
+	}
+}
+
+func TestFirstErr(t *testing.T) {
+	base := runtime.NumGoroutine()
+	err := FirstErr([]Out[int]{never[int](t), OK(1), later(time.Millisecond, Err[int](errTest))}).ErrorOrNil()
+	if !errors.Is(err, errTest) {
+		t.Fatalf("got error %v, want %v", err, errTest)
+	}
+	requireGoroutines(t, base+1)
+}
+
+func TestJoinFailFast(t *testing.T) {
+	base := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	canceled := AsyncCtx(ctx, func(ctx context.Context) Out[int] {
+		<-ctx.Done()
+		return Err[int](ctx.Err())
+	})
+	err := JoinFailFast([]Out[int]{never[int](t), canceled, OK(1), later(time.Millisecond, Err[int](errTest))}).ErrorOrNil()
+	if !errors.Is(err, errTest) {
+		t.Fatalf("got error %v, want %v", err, errTest)
+	}
+	if err := canceled.ErrorOrNil(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+	requireGoroutines(t, base+1)
+}
+
+func TestJoinAll(t *testing.T) {
+	errOther := errors.New("other error")
+	results := func() []Out[int] {
+		return []Out[int]{OK(1), Err[int](errTest), later(time.Millisecond, OK(3)), later(time.Millisecond, Err[int](errOther))}
+	}
+	want := []IndexError{{Index: 1, Err: errTest}, {Index: 3, Err: errOther}}
+	tests := []struct {
+		name string
+		err  func() error
+	}{
+		{
+			name: "join",
+			err: func() error {
+				return JoinAll(results()).ErrorOrNil()
+			},
+		},
+		{
+			name: "proof",
+			err: func() error {
+				return ProofAll(containers(results())...).ErrorOrNil()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.err()
+			joined, ok := err.(interface{ Unwrap() []error })
+			if !ok {
+				t.Fatalf("got error %v, want joined errors", err)
+			}
+			var got []IndexError
+			for _, err := range joined.Unwrap() {
+				var ie *IndexError
+				if !errors.As(err, &ie) {
+					t.Fatalf("got error %v, want *IndexError", err)
+				}
+				got = append(got, *ie)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+		})
+	}
+
+	got, err := JoinAll([]Out[int]{OK(1), later(time.Millisecond, OK(2))}).Unwrap()
+	if err != nil {
+		t.Fatalf("got error %v", err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,129 @@
+package wrap
+
+import (
+	"sync"
+	"time"
+)
+
+type lazyOut[T any] struct {
+	once    sync.Once
+	started sync.Once
+	fn      func() Out[T]
+	future  *asyncOut[T]
+}
+
+// Lazy returns Out which calls fn on the first inspection only and keeps its
+// result, so a branch which is never read costs nothing. Blocking methods call
+// fn on the current goroutine, Done, Poll and TryUnwrap start it by the
+// DefaultExecutor set at the time Lazy is called. A panic inside fn turns into Err holding *PanicError.
+func Lazy[T any](fn func() Out[T]) Out[T] {
+	return &lazyOut[T]{fn: fn, future: newAsyncOut[T](DefaultExecutor(), nil)}
+}
+
+func (l *lazyOut[T]) run() {
+	l.once.Do(func() {
+		l.future.resolve(Safe(l.fn))
+	})
+}
+
+func (l *lazyOut[T]) runInBackground() {
+	l.started.Do(func() {
+		select {
+		case <-l.future.done:
+		default:
+			l.future.executor.Go(l.run)
+		}
+	})
+}
+
+func (l *lazyOut[T]) executedBy() Executor {
+	return l.future.executor
+}
+
+func (l *lazyOut[T]) force() Out[T] {
+	l.run()
+	return l.future.waitResult()
+}
+
+// Done implements Result.
+func (l *lazyOut[T]) Done() <-chan struct{} {
+	l.runInBackground()
+	return l.future.Done()
+}
+
+// Poll implements Result.
+func (l *lazyOut[T]) Poll() (Out[T], bool) {
+	l.runInBackground()
+	return l.future.Poll()
+}
+
+// TryUnwrap implements Result.
+func (l *lazyOut[T]) TryUnwrap(timeout time.Duration) (T, error) {
+	l.runInBackground()
+	return l.future.TryUnwrap(timeout)
+}
+
+// ErrorOrNil implements Result.
+func (l *lazyOut[T]) ErrorOrNil() error {
+	return l.force().ErrorOrNil()
+}
+
+// Flat implements Result.
+func (l *lazyOut[T]) Flat(onOK func(T), onError func(error)) Out[T] {
+	return l.force().Flat(onOK, onError)
+}
+
+// GetOrDefault implements Result.
+func (l *lazyOut[T]) GetOrDefault(defaultValue T) T {
+	return l.force().GetOrDefault(defaultValue)
+}
+
+// GetOrNil implements Result.
+func (l *lazyOut[T]) GetOrNil() *T {
+	return l.force().GetOrNil()
+}
+
+// IfError implements Result.
+func (l *lazyOut[T]) IfError(onError func(error)) Out[T] {
+	return l.force().IfError(onError)
+}
+
+// IfErrorIs implements Result.
+func (l *lazyOut[T]) IfErrorIs(target error, onError func(error)) Out[T] {
+	return l.force().IfErrorIs(target, onError)
+}
+
+// IfOK implements Result.
+func (l *lazyOut[T]) IfOK(onOK func(T)) Out[T] {
+	return l.force().IfOK(onOK)
+}
+
+// MapErr implements Result.
+func (l *lazyOut[T]) MapErr(f func(error) error) Out[T] {
+	return l.force().MapErr(f)
+}
+
+// Recover implements Result.
+func (l *lazyOut[T]) Recover(f func(error) Out[T]) Out[T] {
+	return l.force().Recover(f)
+}
+
+// OrElse implements Result.
+func (l *lazyOut[T]) OrElse(fallback Out[T]) Out[T] {
+	return l.force().OrElse(fallback)
+}
+
+// IsError implements Result.
+func (l *lazyOut[T]) IsError() bool {
+	return l.force().IsError()
+}
+
+// IsOK implements Result.
+func (l *lazyOut[T]) IsOK() bool {
+	return l.force().IsOK()
+}
+
+// Unwrap implements Result.
+func (l *lazyOut[T]) Unwrap() (T, error) {
+	return l.force().Unwrap()
+}
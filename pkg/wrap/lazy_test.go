@@ -0,0 +1,66 @@
+package wrap
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLazy(t *testing.T) {
+	t.Run("never inspected", func(t *testing.T) {
+		var calls atomic.Int32
+		_ = Lazy(func() Out[int] {
+			calls.Add(1)
+			return OK(1)
+		})
+		time.Sleep(10 * time.Millisecond)
+		if got := calls.Load(); got != 0 {
+			t.Fatalf("fn is called %d times, want 0", got)
+		}
+	})
+	t.Run("concurrent inspection", func(t *testing.T) {
+		var calls atomic.Int32
+		r := Lazy(func() Out[int] {
+			calls.Add(1)
+			time.Sleep(time.Millisecond)
+			return OK(1)
+		})
+		inspect := []func(){
+			func() { r.IsOK() },
+			func() { <-r.Done() },
+			func() { r.Poll() },
+		}
+		wg := sync.WaitGroup{}
+		for i := 0; i < 30; i++ {
+			f := inspect[i%len(inspect)]
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				f()
+			}()
+		}
+		wg.Wait()
+		<-r.Done()
+		if got := calls.Load(); got != 1 {
+			t.Fatalf("fn is called %d times, want 1", got)
+		}
+	})
+	t.Run("executor of the call", func(t *testing.T) {
+		s := NewScheduler()
+		prev := SetDefaultExecutor(s)
+		r := Lazy(func() Out[int] {
+			return OK(1)
+		})
+		SetDefaultExecutor(prev)
+
+		// Poll hands fn to s, so it runs only once s is stepped.
+		if _, ok := r.Poll(); ok {
+			t.Fatal("result is settled before the scheduler runs")
+		}
+		s.Run()
+		if _, ok := r.Poll(); !ok {
+			t.Fatal("result isn't settled after the scheduler runs")
+		}
+	})
+}
@@ -1,5 +1,7 @@
 package wrap
 
+import "time"
+
 type ok[T any] struct {
 	v T
 }
@@ -33,11 +35,27 @@ func (s ok[T]) IfError(onError func(error)) Out[T] {
 	return s
 }
 
+func (s ok[T]) IfErrorIs(error, func(error)) Out[T] {
+	return s
+}
+
 func (s ok[T]) IfOK(onOK func(T)) Out[T] {
 	onOK(s.v)
 	return s
 }
 
+func (s ok[T]) MapErr(func(error) error) Out[T] {
+	return s
+}
+
+func (s ok[T]) Recover(func(error) Out[T]) Out[T] {
+	return s
+}
+
+func (s ok[T]) OrElse(Out[T]) Out[T] {
+	return s
+}
+
 func OK[T any](value T) Out[T] {
 	output := new(ok[T])
 	output.v = value
@@ -64,3 +82,15 @@ func OKSlice[T any](values []T) []Out[T] {
 func (s ok[T]) Unwrap() (T, error) {
 	return s.v, nil
 }
+
+func (s ok[T]) TryUnwrap(time.Duration) (T, error) {
+	return s.Unwrap()
+}
+
+func (ok[T]) Done() <-chan struct{} {
+	return closedCh
+}
+
+func (s ok[T]) Poll() (Out[T], bool) {
+	return s, true
+}
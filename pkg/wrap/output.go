@@ -1,5 +1,7 @@
 package wrap
 
+import "time"
+
 type (
 	Empty struct{}
 
@@ -7,6 +9,14 @@ type (
 		ErrorOrNil() error
 		IsOK() bool
 		IsError() bool
+		// Done is closed once the result is settled.
+		Done() <-chan struct{}
+	}
+
+	// Canceler is implemented by results which can be told to stop producing
+	// their value, e.g. the ones created by AsyncCtx.
+	Canceler interface {
+		Cancel()
 	}
 
 	Out[T any] interface {
@@ -15,7 +25,22 @@ type (
 		GetOrNil() *T
 		IfOK(onOk func(T)) Out[T]
 		IfError(onError func(error)) Out[T]
+		IfErrorIs(target error, onError func(error)) Out[T]
 		Flat(onOK func(T), onError func(error)) Out[T]
+		// MapErr replaces the error with the one given by f, unless f returns nil.
+		MapErr(f func(error) error) Out[T]
+		Recover(f func(error) Out[T]) Out[T]
+		OrElse(fallback Out[T]) Out[T]
 		Unwrap() (T, error)
+		// Poll returns the settled result without blocking, false if it's not ready yet.
+		Poll() (Out[T], bool)
+		TryUnwrap(timeout time.Duration) (T, error)
 	}
 )
+
+// closedCh is returned by Done of results which are settled from the start.
+var closedCh = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
@@ -0,0 +1,47 @@
+package wrap
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError holds the value recovered from a panic together with the stack of
+// the goroutine which panicked.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the recovered value if it's an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Safe calls fn and turns a panic inside of it into Err holding *PanicError.
+func Safe[T any](fn func() Out[T]) (res Out[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			res = Err[T](&PanicError{Value: v, Stack: debug.Stack()})
+		}
+	}()
+	return fn()
+}
+
+// AndSafe works like And, but a panic inside f turns into Err holding *PanicError.
+func AndSafe[T any, TT any](r Out[T], f Successor[T, TT]) Out[TT] {
+	return Safe(func() Out[TT] {
+		return And(r, f)
+	})
+}
+
+// EachSafe works like Each, but a panic inside f turns into Err holding *PanicError.
+func EachSafe[T any, TT any](r []Out[T], f Successor[T, TT]) []Out[TT] {
+	return eachFunc(r, f, AndSafe)
+}
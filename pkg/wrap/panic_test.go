@@ -0,0 +1,71 @@
+package wrap
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPanic(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(f Successor[int, int]) Out[int]
+	}{
+		{
+			name: "async",
+			run: func(f Successor[int, int]) Out[int] {
+				return Async(func() Out[int] {
+					return f(1)
+				})
+			},
+		},
+		{
+			name: "each async",
+			run: func(f Successor[int, int]) Out[int] {
+				return EachAsync([]Out[int]{OK(1)}, f)[0]
+			},
+		},
+		{
+			name: "and safe",
+			run: func(f Successor[int, int]) Out[int] {
+				return AndSafe(OK(1), f)
+			},
+		},
+		{
+			name: "each safe",
+			run: func(f Successor[int, int]) Out[int] {
+				return EachSafe([]Out[int]{OK(1)}, f)[0]
+			},
+		},
+	}
+	values := []struct {
+		name  string
+		value any
+	}{
+		{name: "string", value: "boom"},
+		{name: "error", value: errTest},
+	}
+	for _, tt := range tests {
+		for _, v := range values {
+			tt, v := tt, v
+			t.Run(tt.name+" "+v.name, func(t *testing.T) {
+				err := tt.run(func(int) Out[int] {
+					panic(v.value)
+				}).ErrorOrNil()
+
+				var pe *PanicError
+				if !errors.As(err, &pe) {
+					t.Fatalf("got error %v, want *PanicError", err)
+				}
+				if pe.Value != v.value {
+					t.Fatalf("got value %v, want %v", pe.Value, v.value)
+				}
+				if len(pe.Stack) == 0 {
+					t.Fatal("stack is empty")
+				}
+				if _, isErr := v.value.(error); errors.Is(err, errTest) != isErr {
+					t.Fatalf("errors.Is(%v, errTest) = %t, want %t", err, !isErr, isErr)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package wrap
+
+func cancelOthers(r []ErrorContainer, winner int) {
+	for i, v := range r {
+		if i != winner {
+			Cancel(v)
+		}
+	}
+}
+
+// settleFirst returns the index of the first result to settle and cancels the
+// rest of them, -1 if r is empty.
+func settleFirst(r []ErrorContainer) int {
+	winner := -1
+	eachSettled(r, func(i int) bool {
+		winner = i
+		return false
+	})
+	if winner >= 0 {
+		cancelOthers(r, winner)
+	}
+	return winner
+}
+
+// Race returns the first result to settle, no matter OK or Err, and cancels
+// the rest of them (see Canceler). It's Err(ErrNotFound) if r is empty.
+func Race[T any](r ...Out[T]) Out[T] {
+	winner := settleFirst(containers(r))
+	if winner < 0 {
+		return Err[T](ErrNotFound)
+	}
+	return r[winner]
+}
+
+func RaceAsync[T any](r ...Out[T]) Out[T] {
+	return Async(func() Out[T] {
+		return Race(r...)
+	})
+}
+
+// Select is Race for results of different types: it gives the index of the
+// first one to settle, or Err holding *IndexError if that one failed.
+func Select(r ...ErrorContainer) Out[int] {
+	winner := settleFirst(r)
+	if winner < 0 {
+		return Err[int](ErrNotFound)
+	}
+	if err := r[winner].ErrorOrNil(); err != nil {
+		return Err[int](&IndexError{Index: winner, Err: err})
+	}
+	return OK(winner)
+}
+
+func SelectAsync(r ...ErrorContainer) Out[int] {
+	return Async(func() Out[int] {
+		return Select(r...)
+	})
+}
@@ -0,0 +1,118 @@
+package wrap
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+type (
+	// Clock is used by Retry to wait between attempts, so tests can replace
+	// real sleeps with a fake one.
+	Clock interface {
+		After(d time.Duration) <-chan time.Time
+	}
+
+	// Backoff returns the delay before the next attempt, attempt starts from 1.
+	Backoff func(attempt int) time.Duration
+
+	RetryPolicy struct {
+		// MaxAttempts limits the number of calls, it's DefaultMaxAttempts if 0,
+		// a negative one means no limit.
+		MaxAttempts int
+		// Backoff gives the delay between attempts, nil means no delay.
+		Backoff Backoff
+		// Retryable tells which errors are worth another attempt, nil means all of them.
+		Retryable func(error) bool
+		// Clock is SystemClock if nil.
+		Clock Clock
+	}
+
+	systemClock struct{}
+)
+
+// DefaultMaxAttempts is used by RetryPolicy without MaxAttempts, so a zero
+// policy doesn't retry a persistent error forever.
+const DefaultMaxAttempts = 3
+
+var SystemClock Clock = systemClock{}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff doubles the delay after each attempt starting from base,
+// but never returns more than limit (if it's positive).
+func ExponentialBackoff(base, limit time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt && d < math.MaxInt64/2 && (limit <= 0 || d < limit); i++ {
+			d *= 2
+		}
+		if limit > 0 && d > limit {
+			return limit
+		}
+		return d
+	}
+}
+
+// JitteredBackoff picks a random delay between 0 and the one given by b.
+func JitteredBackoff(b Backoff) Backoff {
+	return func(attempt int) time.Duration {
+		d := b(attempt)
+		if d <= 0 {
+			return d
+		}
+		return time.Duration(rand.Int63n(int64(d) + 1))
+	}
+}
+
+// Retry calls fn until it gives OK or the policy says to stop, the last result
+// is returned.
+func Retry[T any](policy RetryPolicy, fn func() Out[T]) Out[T] {
+	clock := policy.Clock
+	if clock == nil {
+		clock = SystemClock
+	}
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	for attempt := 1; ; attempt++ {
+		res := fn()
+		err := res.ErrorOrNil()
+		if err == nil ||
+			(maxAttempts > 0 && attempt >= maxAttempts) ||
+			(policy.Retryable != nil && !policy.Retryable(err)) {
+			return res
+		}
+		if policy.Backoff == nil {
+			continue
+		}
+		if d := policy.Backoff(attempt); d > 0 {
+			<-clock.After(d)
+		}
+	}
+}
+
+func RetryAsync[T any](policy RetryPolicy, fn func() Out[T]) Out[T] {
+	return Async(func() Out[T] {
+		return Retry(policy, fn)
+	})
+}
+
+// Retrying turns f into Successor which is retried according to the policy,
+// so it can be passed to And, EachAsync and others as is.
+func Retrying[T any, TT any](policy RetryPolicy, f Successor[T, TT]) Successor[T, TT] {
+	return func(v T) Out[TT] {
+		return Retry(policy, func() Out[TT] {
+			return f(v)
+		})
+	}
+}
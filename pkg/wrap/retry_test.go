@@ -0,0 +1,115 @@
+package wrap
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeClock doesn't sleep, it records the delays instead.
+type fakeClock struct {
+	delays []time.Duration
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.delays = append(c.delays, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+// failing returns fn which fails n times and then gives the number of calls.
+func failing(n int, err error) (fn func() Out[int], calls *int) {
+	calls = new(int)
+	return func() Out[int] {
+		*calls++
+		if *calls <= n {
+			return Err[int](err)
+		}
+		return OK(*calls)
+	}, calls
+}
+
+func TestRetry(t *testing.T) {
+	errFatal := errors.New("fatal")
+	tests := []struct {
+		name       string
+		policy     RetryPolicy
+		failures   int
+		err        error
+		wantCalls  int
+		wantErr    error
+		wantDelays []time.Duration
+	}{
+		{
+			name:      "zero policy stops at DefaultMaxAttempts",
+			failures:  100,
+			err:       errTest,
+			wantCalls: DefaultMaxAttempts,
+			wantErr:   errTest,
+		},
+		{
+			name:      "no limit",
+			policy:    RetryPolicy{MaxAttempts: -1},
+			failures:  10,
+			err:       errTest,
+			wantCalls: 11,
+		},
+		{
+			name:       "exponential backoff",
+			policy:     RetryPolicy{MaxAttempts: 5, Backoff: ExponentialBackoff(time.Second, 5*time.Second)},
+			failures:   10,
+			err:        errTest,
+			wantCalls:  5,
+			wantErr:    errTest,
+			wantDelays: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second},
+		},
+		{
+			name:       "constant backoff until OK",
+			policy:     RetryPolicy{MaxAttempts: 5, Backoff: ConstantBackoff(time.Second)},
+			failures:   2,
+			err:        errTest,
+			wantCalls:  3,
+			wantDelays: []time.Duration{time.Second, time.Second},
+		},
+		{
+			name: "not retryable",
+			policy: RetryPolicy{MaxAttempts: 5, Backoff: ConstantBackoff(time.Second), Retryable: func(err error) bool {
+				return !errors.Is(err, errFatal)
+			}},
+			failures:  10,
+			err:       errFatal,
+			wantCalls: 1,
+			wantErr:   errFatal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{}
+			tt.policy.Clock = clock
+			fn, calls := failing(tt.failures, tt.err)
+			err := Retry(tt.policy, fn).ErrorOrNil()
+			if !errors.Is(err, tt.wantErr) || (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if *calls != tt.wantCalls {
+				t.Fatalf("got %d calls, want %d", *calls, tt.wantCalls)
+			}
+			if len(clock.delays) != 0 || len(tt.wantDelays) != 0 {
+				if !reflect.DeepEqual(clock.delays, tt.wantDelays) {
+					t.Fatalf("got delays %v, want %v", clock.delays, tt.wantDelays)
+				}
+			}
+		})
+	}
+}
+
+func TestJitteredBackoff(t *testing.T) {
+	b := JitteredBackoff(ConstantBackoff(time.Second))
+	for i := 1; i <= 100; i++ {
+		if d := b(i); d < 0 || d > time.Second {
+			t.Fatalf("got %v, want it within [0, 1s]", d)
+		}
+	}
+}
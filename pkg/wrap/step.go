@@ -0,0 +1,143 @@
+package wrap
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// StepError tells which step of a chain produced the error.
+type StepError struct {
+	Label string
+	// Index is the position of the element for slice handlers, -1 otherwise.
+	Index int
+	// Site is file:line of the handler call, it's recorded in debug mode only.
+	Site string
+	Err  error
+}
+
+func (e *StepError) Error() string {
+	b := strings.Builder{}
+	b.WriteString("step")
+	if e.Label != "" {
+		b.WriteString(" " + e.Label)
+	}
+	if e.Index >= 0 {
+		fmt.Fprintf(&b, "#%d", e.Index)
+	}
+	if e.Site != "" {
+		fmt.Fprintf(&b, " (%s)", e.Site)
+	}
+	fmt.Fprintf(&b, ": %v", e.Err)
+	return b.String()
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	debugMode atomic.Bool
+	pkgPath   = reflect.TypeOf(Empty{}).PkgPath() + "."
+)
+
+// SetDebug turns on/off debug mode, in which errors produced by successors of
+// And, AndXN and others are wrapped into *StepError with the file and line of
+// the handler call.
+func SetDebug(enabled bool) {
+	debugMode.Store(enabled)
+}
+
+// callSite returns file:line of the first caller outside of this package, or
+// an empty string if debug mode is off or there is no such caller (i.e. on
+// a goroutine started by an Executor).
+func callSite() string {
+	if !debugMode.Load() {
+		return ""
+	}
+	pc := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(2, pc)])
+	for {
+		frame, more := frames.Next()
+		if strings.HasPrefix(frame.Function, "runtime.") {
+			return ""
+		}
+		if !strings.HasPrefix(frame.Function, pkgPath) || strings.HasSuffix(frame.File, "_test.go") {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// mapErr works like Out.MapErr, but doesn't wait for r.
+func mapErr[T any](r Out[T], f func(error) error) Out[T] {
+	if _, ok := r.Poll(); ok {
+		return r.MapErr(f)
+	}
+	return Async(func() Out[T] {
+		return r.MapErr(f)
+	})
+}
+
+// step wraps the error of r into *StepError unless it already has one, so the
+// step which produced the error is kept.
+func step[T any](r Out[T], label string, index int, site string) Out[T] {
+	return mapErr(r, func(err error) error {
+		var stepErr *StepError
+		if errors.As(err, &stepErr) {
+			return err
+		}
+		return &StepError{Label: label, Index: index, Site: site, Err: err}
+	})
+}
+
+// Named wraps the error of r into *StepError with the label, unless the error
+// already came from a named step.
+func Named[T any](label string, r Out[T]) Out[T] {
+	return step(r, label, -1, callSite())
+}
+
+// AndNamed works like And, but errors produced by f are wrapped into *StepError
+// with the label.
+func AndNamed[T any, TT any](label string, r Out[T], f Successor[T, TT]) Out[TT] {
+	site := callSite()
+	return andAt("", r, func(v T) Out[TT] {
+		return step(f(v), label, -1, site)
+	})
+}
+
+func AndNamedAsync[T any, TT any](label string, r Out[T], f Successor[T, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		return andAt("", r, func(v T) Out[TT] {
+			return step(f(v), label, -1, site)
+		})
+	})
+}
+
+func eachNamedFunc[T any, TT any](label string, r []Out[T], f Successor[T, TT], and func(Out[T], Successor[T, TT]) Out[TT]) []Out[TT] {
+	site := callSite()
+	res := make([]Out[TT], 0, len(r))
+	for i, v := range r {
+		i := i
+		res = append(res, and(v, func(v T) Out[TT] {
+			return step(f(v), label, i, site)
+		}))
+	}
+	return res
+}
+
+// EachNamed works like Each, but errors produced by f are wrapped into
+// *StepError with the label and the index of the element.
+func EachNamed[T any, TT any](label string, r []Out[T], f Successor[T, TT]) []Out[TT] {
+	return eachNamedFunc(label, r, f, And)
+}
+
+func EachNamedAsync[T any, TT any](label string, r []Out[T], f Successor[T, TT]) []Out[TT] {
+	return eachNamedFunc(label, r, f, AndAsync)
+}
@@ -0,0 +1,239 @@
+package wrap
+
+import (
+	"context"
+	"sync"
+)
+
+// Stream is a lazy sequence of results read from a channel. Operators start a
+// goroutine each and pass values through unbuffered channels, so a slow reader
+// slows down the whole chain. Once the context of the stream is done, every
+// operator closes its output, so cancel it to release a stream which isn't read
+// till the end (e.g. after Take or the first error in Collect). A panic inside a
+// func given to an operator turns into Err holding *PanicError.
+type Stream[T any] struct {
+	ctx context.Context
+	ch  <-chan Out[T]
+}
+
+func NewStream[T any](ctx context.Context, ch <-chan Out[T]) Stream[T] {
+	return Stream[T]{ctx: ctx, ch: ch}
+}
+
+// StreamChan turns every value read from ch into OK.
+func StreamChan[T any](ctx context.Context, ch <-chan T) Stream[T] {
+	out := make(chan Out[T])
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok || !send(ctx, out, OK(v)) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return NewStream(ctx, out)
+}
+
+func StreamSlice[T any](ctx context.Context, r []Out[T]) Stream[T] {
+	out := make(chan Out[T])
+	go func() {
+		defer close(out)
+		for _, v := range r {
+			if !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return NewStream(ctx, out)
+}
+
+func send[T any](ctx context.Context, ch chan<- Out[T], v Out[T]) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (s Stream[T]) recv() (Out[T], bool) {
+	select {
+	case v, ok := <-s.ch:
+		return v, ok
+	case <-s.ctx.Done():
+		return nil, false
+	}
+}
+
+func (s Stream[T]) Chan() <-chan Out[T] {
+	return s.ch
+}
+
+// Next reads the next result, it's Err(ErrChanClosed) once the stream is over
+// and Err(ctx.Err()) once its context is done.
+func (s Stream[T]) Next() Out[T] {
+	v, ok := s.recv()
+	if ok {
+		return v
+	}
+	if err := s.ctx.Err(); err != nil {
+		return Err[T](err)
+	}
+	return Err[T](ErrChanClosed)
+}
+
+func streamFunc[T any, TT any](s Stream[T], fn func(v Out[T], out chan<- Out[TT]) bool) Stream[TT] {
+	out := make(chan Out[TT])
+	go func() {
+		defer close(out)
+		for {
+			v, ok := s.recv()
+			if !ok || !fn(v, out) {
+				return
+			}
+		}
+	}()
+	return NewStream(s.ctx, out)
+}
+
+func AndStream[T any, TT any](s Stream[T], f Successor[T, TT]) Stream[TT] {
+	return streamFunc(s, func(v Out[T], out chan<- Out[TT]) bool {
+		return send(s.ctx, out, AndSafe(v, f))
+	})
+}
+
+func MapStream[T any, TT any](s Stream[T], f func(T) TT) Stream[TT] {
+	return streamFunc(s, func(v Out[T], out chan<- Out[TT]) bool {
+		return send(s.ctx, out, Safe(func() Out[TT] {
+			return Map(v, f)
+		}))
+	})
+}
+
+// FilterOK drops errors from the stream.
+func (s Stream[T]) FilterOK() Stream[T] {
+	return streamFunc(s, func(v Out[T], out chan<- Out[T]) bool {
+		if v.IsError() {
+			return true
+		}
+		return send(s.ctx, out, v)
+	})
+}
+
+// Take closes the stream after n results, the rest of them stay unread.
+func (s Stream[T]) Take(n int) Stream[T] {
+	out := make(chan Out[T])
+	go func() {
+		defer close(out)
+		for i := 0; i < n; i++ {
+			v, ok := s.recv()
+			if !ok || !send(s.ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return NewStream(s.ctx, out)
+}
+
+// BatchStream groups OK values into slices of n, the last one can be shorter.
+// An error is passed as is right after the values read before it.
+func BatchStream[T any](s Stream[T], n int) Stream[[]T] {
+	if n < 1 {
+		n = 1
+	}
+	out := make(chan Out[[]T])
+	go func() {
+		defer close(out)
+		batch := make([]T, 0, n)
+		flush := func() bool {
+			if len(batch) == 0 {
+				return true
+			}
+			res := batch
+			batch = make([]T, 0, n)
+			return send(s.ctx, out, OK(res))
+		}
+		for {
+			v, ok := s.recv()
+			if !ok {
+				flush()
+				return
+			}
+			val, err := v.Unwrap()
+			if err != nil {
+				if !flush() || !send(s.ctx, out, Err[[]T](err)) {
+					return
+				}
+				continue
+			}
+			batch = append(batch, val)
+			if len(batch) >= n && !flush() {
+				return
+			}
+		}
+	}()
+	return NewStream(s.ctx, out)
+}
+
+// Merge interleaves results of all the streams, the result is closed once all
+// of them are.
+func (s Stream[T]) Merge(others ...Stream[T]) Stream[T] {
+	out := make(chan Out[T])
+	wg := sync.WaitGroup{}
+	for _, v := range append([]Stream[T]{s}, others...) {
+		v := v
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				r, ok := v.recv()
+				if !ok || !send(s.ctx, out, r) {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return NewStream(s.ctx, out)
+}
+
+// FanOut splits the stream between n readers, each result goes to the one
+// which is ready first. The readers share the channel of s.
+func (s Stream[T]) FanOut(n int) []Stream[T] {
+	res := make([]Stream[T], 0, n)
+	for i := 0; i < n; i++ {
+		res = append(res, NewStream(s.ctx, s.ch))
+	}
+	return res
+}
+
+// Collect reads the whole stream, it stops at the first error like Join does.
+func (s Stream[T]) Collect() Out[[]T] {
+	res := []T{}
+	for {
+		v, ok := s.recv()
+		if !ok {
+			if err := s.ctx.Err(); err != nil {
+				return Err[[]T](err)
+			}
+			return OK(res)
+		}
+		val, err := v.Unwrap()
+		if err != nil {
+			return Err[[]T](err)
+		}
+		res = append(res, val)
+	}
+}
+
+func (s Stream[T]) CollectAsync() Out[[]T] {
+	return Async(s.Collect)
+}
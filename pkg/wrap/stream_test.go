@@ -0,0 +1,138 @@
+package wrap
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// collectNext reads s by Next till it's over.
+func collectNext[T any](s Stream[T]) []Out[T] {
+	res := []Out[T]{}
+	for {
+		v := s.Next()
+		if ErrorIs(v, ErrChanClosed) {
+			return res
+		}
+		res = append(res, v)
+	}
+}
+
+func TestStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tests := []struct {
+		name string
+		s    Stream[[]int]
+		want []Out[[]int]
+	}{
+		{
+			name: "take",
+			s: MapStream(StreamSlice(ctx, Range(5, OK[int])).Take(2), func(v int) []int {
+				return []int{v}
+			}),
+			want: []Out[[]int]{OK([]int{0}), OK([]int{1})},
+		},
+		{
+			name: "batch with error",
+			s:    BatchStream(StreamSlice(ctx, []Out[int]{OK(0), OK(1), OK(2), Err[int](errTest), OK(4)}), 2),
+			want: []Out[[]int]{OK([]int{0, 1}), OK([]int{2}), Err[[]int](errTest), OK([]int{4})},
+		},
+		{
+			name: "and",
+			s: AndStream(StreamSlice(ctx, []Out[int]{OK(0), Err[int](errTest)}), func(v int) Out[[]int] {
+				return OK([]int{v})
+			}),
+			want: []Out[[]int]{OK([]int{0}), Err[[]int](errTest)},
+		},
+		{
+			name: "empty",
+			s:    StreamSlice[[]int](ctx, nil),
+			want: []Out[[]int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collectNext(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := StreamSlice(ctx, Range(3, OK[int])).Merge(StreamSlice(ctx, []Out[int]{OK(3), OK(4)}))
+
+	got, err := s.Collect().Unwrap()
+	if err != nil {
+		t.Fatalf("got error %v", err)
+	}
+	sort.Ints(got)
+	if want := []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if err := s.Next().ErrorOrNil(); !errors.Is(err, ErrChanClosed) {
+		t.Fatalf("got error %v, want %v", err, ErrChanClosed)
+	}
+}
+
+func TestCollectCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int)
+	s := MapStream(StreamChan(ctx, ch), func(v int) int {
+		return v
+	})
+	ch <- 1
+	cancel()
+
+	if err := s.Collect().ErrorOrNil(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+	if err := s.Next().ErrorOrNil(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestStreamPanic(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	streams := map[string]Stream[int]{
+		"map": MapStream(StreamSlice(ctx, Range(1, OK[int])), func(int) int {
+			panic("boom")
+		}),
+		"and": AndStream(StreamSlice(ctx, Range(1, OK[int])), func(int) Out[int] {
+			panic("boom")
+		}),
+	}
+	for name, s := range streams {
+		s := s
+		t.Run(name, func(t *testing.T) {
+			var pe *PanicError
+			if err := s.Next().ErrorOrNil(); !errors.As(err, &pe) {
+				t.Fatalf("got error %v, want *PanicError", err)
+			}
+		})
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	streams := StreamSlice(ctx, Range(5, OK[int])).FanOut(2)
+
+	// The second reader is never ready, so every result goes to the first one.
+	got, err := streams[0].Collect().Unwrap()
+	if err != nil {
+		t.Fatalf("got error %v", err)
+	}
+	if want := []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if err := streams[1].Next().ErrorOrNil(); !errors.Is(err, ErrChanClosed) {
+		t.Fatalf("got error %v, want %v", err, ErrChanClosed)
+	}
+}
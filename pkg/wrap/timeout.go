@@ -0,0 +1,65 @@
+package wrap
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeoutError is the error of results which weren't ready in time,
+// errors.Is(err, ErrTimeout) reports true for it.
+type TimeoutError struct {
+	Deadline time.Time
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%v at %v", ErrTimeout, e.Deadline.Format(time.RFC3339Nano))
+}
+
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+func (e *TimeoutError) Timeout() bool {
+	return true
+}
+
+// WithDeadline returns Out which is the same as r if it's ready before the
+// deadline, or Err holding *TimeoutError otherwise. r itself isn't canceled.
+// The deadline doesn't wait for a free Executor, it counts from the call.
+func WithDeadline[T any](r Out[T], deadline time.Time) Out[T] {
+	if _, ok := r.Poll(); ok {
+		return r
+	}
+	expired := make(chan struct{})
+	timer := time.AfterFunc(time.Until(deadline), func() {
+		close(expired)
+	})
+	res := newAsyncOut[T](nil, nil)
+	settle := func() {
+		defer timer.Stop()
+		select {
+		case <-r.Done():
+		case <-expired:
+		}
+		if _, ok := r.Poll(); ok {
+			res.resolve(r)
+			return
+		}
+		res.resolve(Err[T](&TimeoutError{Deadline: deadline}))
+	}
+	if s, ok := stepperOf(r); ok {
+		res.executor = s
+		s.Go(func() {
+			s.Wait(r.Done(), expired)
+			settle()
+		})
+		return res
+	}
+	go settle()
+	return res
+}
+
+// WithTimeout is WithDeadline(r, time.Now().Add(timeout)).
+func WithTimeout[T any](r Out[T], timeout time.Duration) Out[T] {
+	return WithDeadline(r, time.Now().Add(timeout))
+}
@@ -0,0 +1,41 @@
+package wrap
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("busy executor", func(t *testing.T) {
+		prev := SetDefaultExecutor(NewPool(1))
+		t.Cleanup(func() {
+			SetDefaultExecutor(prev)
+		})
+		block := make(chan struct{})
+		t.Cleanup(func() {
+			close(block)
+		})
+		// stuck takes the only worker of the pool.
+		stuck := Async(func() Out[int] {
+			<-block
+			return OK(1)
+		})
+
+		r := WithTimeout(stuck, 20*time.Millisecond)
+		select {
+		case <-r.Done():
+		case <-time.After(time.Second):
+			t.Fatal("not timed out in a second")
+		}
+		if err := r.ErrorOrNil(); !errors.Is(err, ErrTimeout) {
+			t.Fatalf("got error %v, want %v", err, ErrTimeout)
+		}
+	})
+	t.Run("ready in time", func(t *testing.T) {
+		v, err := WithTimeout(later(time.Millisecond, OK(1)), time.Minute).Unwrap()
+		if err != nil || v != 1 {
+			t.Fatalf("got %v, %v, want 1", v, err)
+		}
+	})
+}
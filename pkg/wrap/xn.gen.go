@@ -0,0 +1,1217 @@
+// Code generated by "xngen"; DO NOT EDIT.
+package wrap
+
+import "context"
+
+type (
+	SuccessorX2[T1, T2, TT any]                                                                 func(T1, T2) Out[TT]
+	SuccessorX3[T1, T2, T3, TT any]                                                             func(T1, T2, T3) Out[TT]
+	SuccessorX4[T1, T2, T3, T4, TT any]                                                         func(T1, T2, T3, T4) Out[TT]
+	SuccessorX5[T1, T2, T3, T4, T5, TT any]                                                     func(T1, T2, T3, T4, T5) Out[TT]
+	SuccessorX6[T1, T2, T3, T4, T5, T6, TT any]                                                 func(T1, T2, T3, T4, T5, T6) Out[TT]
+	SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT any]                                             func(T1, T2, T3, T4, T5, T6, T7) Out[TT]
+	SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT any]                                         func(T1, T2, T3, T4, T5, T6, T7, T8) Out[TT]
+	SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any]                                     func(T1, T2, T3, T4, T5, T6, T7, T8, T9) Out[TT]
+	SuccessorX10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT any]                               func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10) Out[TT]
+	SuccessorX11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT any]                          func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11) Out[TT]
+	SuccessorX12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT any]                     func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12) Out[TT]
+	SuccessorX13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT any]                func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13) Out[TT]
+	SuccessorX14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT any]           func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14) Out[TT]
+	SuccessorX15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT any]      func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15) Out[TT]
+	SuccessorX16[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT any] func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16) Out[TT]
+)
+
+func AndX2[T1, T2, TT any](r1 Out[T1], r2 Out[T2], f SuccessorX2[T1, T2, TT]) Out[TT] {
+	allGood := Proof(r1, r2)
+	return andX2(callSite(), allGood, r1, r2, f)
+}
+
+func AndX2Async[T1, T2, TT any](r1 Out[T1], r2 Out[T2], f SuccessorX2[T1, T2, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2)
+		return andX2(site, allGood, r1, r2, f)
+	})
+}
+
+// AndX2AsyncCtx works like AndX2Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX2AsyncCtx[T1, T2, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], f SuccessorX2[T1, T2, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2), ctxSuccessor(ctx, OK[Empty]))
+		return andX2(site, allGood, r1, r2, f)
+	})
+}
+
+// AndX2All works like AndX2, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX2All[T1, T2, TT any](r1 Out[T1], r2 Out[T2], f SuccessorX2[T1, T2, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2)
+	return andX2(callSite(), allGood, r1, r2, f)
+}
+
+func AndX2AllAsync[T1, T2, TT any](r1 Out[T1], r2 Out[T2], f SuccessorX2[T1, T2, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2)
+		return andX2(site, allGood, r1, r2, f)
+	})
+}
+
+func andX2[T1, T2, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], f SuccessorX2[T1, T2, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+		)
+	})
+}
+
+func AndX3[T1, T2, T3, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], f SuccessorX3[T1, T2, T3, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3)
+	return andX3(callSite(), allGood, r1, r2, r3, f)
+}
+
+func AndX3Async[T1, T2, T3, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], f SuccessorX3[T1, T2, T3, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3)
+		return andX3(site, allGood, r1, r2, r3, f)
+	})
+}
+
+// AndX3AsyncCtx works like AndX3Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX3AsyncCtx[T1, T2, T3, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], f SuccessorX3[T1, T2, T3, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3), ctxSuccessor(ctx, OK[Empty]))
+		return andX3(site, allGood, r1, r2, r3, f)
+	})
+}
+
+// AndX3All works like AndX3, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX3All[T1, T2, T3, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], f SuccessorX3[T1, T2, T3, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3)
+	return andX3(callSite(), allGood, r1, r2, r3, f)
+}
+
+func AndX3AllAsync[T1, T2, T3, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], f SuccessorX3[T1, T2, T3, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3)
+		return andX3(site, allGood, r1, r2, r3, f)
+	})
+}
+
+func andX3[T1, T2, T3, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], f SuccessorX3[T1, T2, T3, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+		)
+	})
+}
+
+func AndX4[T1, T2, T3, T4, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], f SuccessorX4[T1, T2, T3, T4, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4)
+	return andX4(callSite(), allGood, r1, r2, r3, r4, f)
+}
+
+func AndX4Async[T1, T2, T3, T4, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], f SuccessorX4[T1, T2, T3, T4, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4)
+		return andX4(site, allGood, r1, r2, r3, r4, f)
+	})
+}
+
+// AndX4AsyncCtx works like AndX4Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX4AsyncCtx[T1, T2, T3, T4, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], f SuccessorX4[T1, T2, T3, T4, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4), ctxSuccessor(ctx, OK[Empty]))
+		return andX4(site, allGood, r1, r2, r3, r4, f)
+	})
+}
+
+// AndX4All works like AndX4, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX4All[T1, T2, T3, T4, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], f SuccessorX4[T1, T2, T3, T4, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4)
+	return andX4(callSite(), allGood, r1, r2, r3, r4, f)
+}
+
+func AndX4AllAsync[T1, T2, T3, T4, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], f SuccessorX4[T1, T2, T3, T4, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4)
+		return andX4(site, allGood, r1, r2, r3, r4, f)
+	})
+}
+
+func andX4[T1, T2, T3, T4, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], f SuccessorX4[T1, T2, T3, T4, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+		)
+	})
+}
+
+func AndX5[T1, T2, T3, T4, T5, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], f SuccessorX5[T1, T2, T3, T4, T5, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5)
+	return andX5(callSite(), allGood, r1, r2, r3, r4, r5, f)
+}
+
+func AndX5Async[T1, T2, T3, T4, T5, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], f SuccessorX5[T1, T2, T3, T4, T5, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5)
+		return andX5(site, allGood, r1, r2, r3, r4, r5, f)
+	})
+}
+
+// AndX5AsyncCtx works like AndX5Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX5AsyncCtx[T1, T2, T3, T4, T5, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], f SuccessorX5[T1, T2, T3, T4, T5, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5), ctxSuccessor(ctx, OK[Empty]))
+		return andX5(site, allGood, r1, r2, r3, r4, r5, f)
+	})
+}
+
+// AndX5All works like AndX5, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX5All[T1, T2, T3, T4, T5, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], f SuccessorX5[T1, T2, T3, T4, T5, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5)
+	return andX5(callSite(), allGood, r1, r2, r3, r4, r5, f)
+}
+
+func AndX5AllAsync[T1, T2, T3, T4, T5, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], f SuccessorX5[T1, T2, T3, T4, T5, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5)
+		return andX5(site, allGood, r1, r2, r3, r4, r5, f)
+	})
+}
+
+func andX5[T1, T2, T3, T4, T5, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], f SuccessorX5[T1, T2, T3, T4, T5, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+		)
+	})
+}
+
+func AndX6[T1, T2, T3, T4, T5, T6, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], f SuccessorX6[T1, T2, T3, T4, T5, T6, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6)
+	return andX6(callSite(), allGood, r1, r2, r3, r4, r5, r6, f)
+}
+
+func AndX6Async[T1, T2, T3, T4, T5, T6, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], f SuccessorX6[T1, T2, T3, T4, T5, T6, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6)
+		return andX6(site, allGood, r1, r2, r3, r4, r5, r6, f)
+	})
+}
+
+// AndX6AsyncCtx works like AndX6Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX6AsyncCtx[T1, T2, T3, T4, T5, T6, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], f SuccessorX6[T1, T2, T3, T4, T5, T6, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6), ctxSuccessor(ctx, OK[Empty]))
+		return andX6(site, allGood, r1, r2, r3, r4, r5, r6, f)
+	})
+}
+
+// AndX6All works like AndX6, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX6All[T1, T2, T3, T4, T5, T6, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], f SuccessorX6[T1, T2, T3, T4, T5, T6, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6)
+	return andX6(callSite(), allGood, r1, r2, r3, r4, r5, r6, f)
+}
+
+func AndX6AllAsync[T1, T2, T3, T4, T5, T6, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], f SuccessorX6[T1, T2, T3, T4, T5, T6, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6)
+		return andX6(site, allGood, r1, r2, r3, r4, r5, r6, f)
+	})
+}
+
+func andX6[T1, T2, T3, T4, T5, T6, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], f SuccessorX6[T1, T2, T3, T4, T5, T6, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+		)
+	})
+}
+
+func AndX7[T1, T2, T3, T4, T5, T6, T7, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], f SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7)
+	return andX7(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, f)
+}
+
+func AndX7Async[T1, T2, T3, T4, T5, T6, T7, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], f SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7)
+		return andX7(site, allGood, r1, r2, r3, r4, r5, r6, r7, f)
+	})
+}
+
+// AndX7AsyncCtx works like AndX7Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX7AsyncCtx[T1, T2, T3, T4, T5, T6, T7, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], f SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7), ctxSuccessor(ctx, OK[Empty]))
+		return andX7(site, allGood, r1, r2, r3, r4, r5, r6, r7, f)
+	})
+}
+
+// AndX7All works like AndX7, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX7All[T1, T2, T3, T4, T5, T6, T7, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], f SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7)
+	return andX7(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, f)
+}
+
+func AndX7AllAsync[T1, T2, T3, T4, T5, T6, T7, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], f SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7)
+		return andX7(site, allGood, r1, r2, r3, r4, r5, r6, r7, f)
+	})
+}
+
+func andX7[T1, T2, T3, T4, T5, T6, T7, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], f SuccessorX7[T1, T2, T3, T4, T5, T6, T7, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+		)
+	})
+}
+
+func AndX8[T1, T2, T3, T4, T5, T6, T7, T8, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], f SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8)
+	return andX8(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, f)
+}
+
+func AndX8Async[T1, T2, T3, T4, T5, T6, T7, T8, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], f SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8)
+		return andX8(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, f)
+	})
+}
+
+// AndX8AsyncCtx works like AndX8Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX8AsyncCtx[T1, T2, T3, T4, T5, T6, T7, T8, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], f SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7, r8), ctxSuccessor(ctx, OK[Empty]))
+		return andX8(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, f)
+	})
+}
+
+// AndX8All works like AndX8, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX8All[T1, T2, T3, T4, T5, T6, T7, T8, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], f SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8)
+	return andX8(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, f)
+}
+
+func AndX8AllAsync[T1, T2, T3, T4, T5, T6, T7, T8, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], f SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8)
+		return andX8(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, f)
+	})
+}
+
+func andX8[T1, T2, T3, T4, T5, T6, T7, T8, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], f SuccessorX8[T1, T2, T3, T4, T5, T6, T7, T8, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		var defaultV8 T8
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+			r8.GetOrDefault(defaultV8),
+		)
+	})
+}
+
+func AndX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], f SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9)
+	return andX9(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, f)
+}
+
+func AndX9Async[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], f SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9)
+		return andX9(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, f)
+	})
+}
+
+// AndX9AsyncCtx works like AndX9Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX9AsyncCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], f SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9), ctxSuccessor(ctx, OK[Empty]))
+		return andX9(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, f)
+	})
+}
+
+// AndX9All works like AndX9, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX9All[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], f SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9)
+	return andX9(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, f)
+}
+
+func AndX9AllAsync[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], f SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9)
+		return andX9(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, f)
+	})
+}
+
+func andX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], f SuccessorX9[T1, T2, T3, T4, T5, T6, T7, T8, T9, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		var defaultV8 T8
+		var defaultV9 T9
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+			r8.GetOrDefault(defaultV8),
+			r9.GetOrDefault(defaultV9),
+		)
+	})
+}
+
+func AndX10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], f SuccessorX10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10)
+	return andX10(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, f)
+}
+
+func AndX10Async[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], f SuccessorX10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10)
+		return andX10(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, f)
+	})
+}
+
+// AndX10AsyncCtx works like AndX10Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX10AsyncCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], f SuccessorX10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10), ctxSuccessor(ctx, OK[Empty]))
+		return andX10(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, f)
+	})
+}
+
+// AndX10All works like AndX10, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX10All[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], f SuccessorX10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10)
+	return andX10(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, f)
+}
+
+func AndX10AllAsync[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], f SuccessorX10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10)
+		return andX10(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, f)
+	})
+}
+
+func andX10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], f SuccessorX10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		var defaultV8 T8
+		var defaultV9 T9
+		var defaultV10 T10
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+			r8.GetOrDefault(defaultV8),
+			r9.GetOrDefault(defaultV9),
+			r10.GetOrDefault(defaultV10),
+		)
+	})
+}
+
+func AndX11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], f SuccessorX11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11)
+	return andX11(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, f)
+}
+
+func AndX11Async[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], f SuccessorX11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11)
+		return andX11(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, f)
+	})
+}
+
+// AndX11AsyncCtx works like AndX11Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX11AsyncCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], f SuccessorX11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11), ctxSuccessor(ctx, OK[Empty]))
+		return andX11(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, f)
+	})
+}
+
+// AndX11All works like AndX11, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX11All[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], f SuccessorX11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11)
+	return andX11(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, f)
+}
+
+func AndX11AllAsync[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], f SuccessorX11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11)
+		return andX11(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, f)
+	})
+}
+
+func andX11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], f SuccessorX11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		var defaultV8 T8
+		var defaultV9 T9
+		var defaultV10 T10
+		var defaultV11 T11
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+			r8.GetOrDefault(defaultV8),
+			r9.GetOrDefault(defaultV9),
+			r10.GetOrDefault(defaultV10),
+			r11.GetOrDefault(defaultV11),
+		)
+	})
+}
+
+func AndX12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], f SuccessorX12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12)
+	return andX12(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, f)
+}
+
+func AndX12Async[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], f SuccessorX12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12)
+		return andX12(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, f)
+	})
+}
+
+// AndX12AsyncCtx works like AndX12Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX12AsyncCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], f SuccessorX12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12), ctxSuccessor(ctx, OK[Empty]))
+		return andX12(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, f)
+	})
+}
+
+// AndX12All works like AndX12, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX12All[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], f SuccessorX12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12)
+	return andX12(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, f)
+}
+
+func AndX12AllAsync[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], f SuccessorX12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12)
+		return andX12(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, f)
+	})
+}
+
+func andX12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], f SuccessorX12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		var defaultV8 T8
+		var defaultV9 T9
+		var defaultV10 T10
+		var defaultV11 T11
+		var defaultV12 T12
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+			r8.GetOrDefault(defaultV8),
+			r9.GetOrDefault(defaultV9),
+			r10.GetOrDefault(defaultV10),
+			r11.GetOrDefault(defaultV11),
+			r12.GetOrDefault(defaultV12),
+		)
+	})
+}
+
+func AndX13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], f SuccessorX13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13)
+	return andX13(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, f)
+}
+
+func AndX13Async[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], f SuccessorX13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13)
+		return andX13(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, f)
+	})
+}
+
+// AndX13AsyncCtx works like AndX13Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX13AsyncCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], f SuccessorX13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13), ctxSuccessor(ctx, OK[Empty]))
+		return andX13(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, f)
+	})
+}
+
+// AndX13All works like AndX13, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX13All[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], f SuccessorX13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13)
+	return andX13(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, f)
+}
+
+func AndX13AllAsync[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], f SuccessorX13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13)
+		return andX13(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, f)
+	})
+}
+
+func andX13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], f SuccessorX13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		var defaultV8 T8
+		var defaultV9 T9
+		var defaultV10 T10
+		var defaultV11 T11
+		var defaultV12 T12
+		var defaultV13 T13
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+			r8.GetOrDefault(defaultV8),
+			r9.GetOrDefault(defaultV9),
+			r10.GetOrDefault(defaultV10),
+			r11.GetOrDefault(defaultV11),
+			r12.GetOrDefault(defaultV12),
+			r13.GetOrDefault(defaultV13),
+		)
+	})
+}
+
+func AndX14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], f SuccessorX14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14)
+	return andX14(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, f)
+}
+
+func AndX14Async[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], f SuccessorX14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14)
+		return andX14(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, f)
+	})
+}
+
+// AndX14AsyncCtx works like AndX14Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX14AsyncCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], f SuccessorX14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14), ctxSuccessor(ctx, OK[Empty]))
+		return andX14(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, f)
+	})
+}
+
+// AndX14All works like AndX14, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX14All[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], f SuccessorX14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14)
+	return andX14(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, f)
+}
+
+func AndX14AllAsync[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], f SuccessorX14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14)
+		return andX14(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, f)
+	})
+}
+
+func andX14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], f SuccessorX14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		var defaultV8 T8
+		var defaultV9 T9
+		var defaultV10 T10
+		var defaultV11 T11
+		var defaultV12 T12
+		var defaultV13 T13
+		var defaultV14 T14
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+			r8.GetOrDefault(defaultV8),
+			r9.GetOrDefault(defaultV9),
+			r10.GetOrDefault(defaultV10),
+			r11.GetOrDefault(defaultV11),
+			r12.GetOrDefault(defaultV12),
+			r13.GetOrDefault(defaultV13),
+			r14.GetOrDefault(defaultV14),
+		)
+	})
+}
+
+func AndX15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], f SuccessorX15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15)
+	return andX15(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, f)
+}
+
+func AndX15Async[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], f SuccessorX15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15)
+		return andX15(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, f)
+	})
+}
+
+// AndX15AsyncCtx works like AndX15Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX15AsyncCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], f SuccessorX15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15), ctxSuccessor(ctx, OK[Empty]))
+		return andX15(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, f)
+	})
+}
+
+// AndX15All works like AndX15, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX15All[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], f SuccessorX15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15)
+	return andX15(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, f)
+}
+
+func AndX15AllAsync[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], f SuccessorX15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15)
+		return andX15(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, f)
+	})
+}
+
+func andX15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], f SuccessorX15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		var defaultV8 T8
+		var defaultV9 T9
+		var defaultV10 T10
+		var defaultV11 T11
+		var defaultV12 T12
+		var defaultV13 T13
+		var defaultV14 T14
+		var defaultV15 T15
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+			r8.GetOrDefault(defaultV8),
+			r9.GetOrDefault(defaultV9),
+			r10.GetOrDefault(defaultV10),
+			r11.GetOrDefault(defaultV11),
+			r12.GetOrDefault(defaultV12),
+			r13.GetOrDefault(defaultV13),
+			r14.GetOrDefault(defaultV14),
+			r15.GetOrDefault(defaultV15),
+		)
+	})
+}
+
+func AndX16[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], r16 Out[T16], f SuccessorX16[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT]) Out[TT] {
+	allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16)
+	return andX16(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16, f)
+}
+
+func AndX16Async[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], r16 Out[T16], f SuccessorX16[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16)
+		return andX16(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16, f)
+	})
+}
+
+// AndX16AsyncCtx works like AndX16Async, but f isn't called once ctx is done, see AsyncCtx.
+func AndX16AsyncCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT any](ctx context.Context, r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], r16 Out[T16], f SuccessorX16[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT]) Out[TT] {
+	site := callSite()
+	return AsyncCtx(ctx, func(ctx context.Context) Out[TT] {
+		allGood := andAt("", Proof(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16), ctxSuccessor(ctx, OK[Empty]))
+		return andX16(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16, f)
+	})
+}
+
+// AndX16All works like AndX16, but the error it returns joins the errors of
+// all the failed arguments, see ProofAll.
+func AndX16All[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], r16 Out[T16], f SuccessorX16[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT]) Out[TT] {
+	allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16)
+	return andX16(callSite(), allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16, f)
+}
+
+func AndX16AllAsync[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], r16 Out[T16], f SuccessorX16[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT]) Out[TT] {
+	site := callSite()
+	return Async(func() Out[TT] {
+		allGood := ProofAll(r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16)
+		return andX16(site, allGood, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16, f)
+	})
+}
+
+func andX16[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT any](site string, allGood Out[Empty], r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9], r10 Out[T10], r11 Out[T11], r12 Out[T12], r13 Out[T13], r14 Out[T14], r15 Out[T15], r16 Out[T16], f SuccessorX16[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16, TT]) Out[TT] {
+	return andAt(site, allGood, func(Empty) Out[TT] {
+		var defaultV1 T1
+		var defaultV2 T2
+		var defaultV3 T3
+		var defaultV4 T4
+		var defaultV5 T5
+		var defaultV6 T6
+		var defaultV7 T7
+		var defaultV8 T8
+		var defaultV9 T9
+		var defaultV10 T10
+		var defaultV11 T11
+		var defaultV12 T12
+		var defaultV13 T13
+		var defaultV14 T14
+		var defaultV15 T15
+		var defaultV16 T16
+		return f(r1.GetOrDefault(defaultV1),
+			r2.GetOrDefault(defaultV2),
+			r3.GetOrDefault(defaultV3),
+			r4.GetOrDefault(defaultV4),
+			r5.GetOrDefault(defaultV5),
+			r6.GetOrDefault(defaultV6),
+			r7.GetOrDefault(defaultV7),
+			r8.GetOrDefault(defaultV8),
+			r9.GetOrDefault(defaultV9),
+			r10.GetOrDefault(defaultV10),
+			r11.GetOrDefault(defaultV11),
+			r12.GetOrDefault(defaultV12),
+			r13.GetOrDefault(defaultV13),
+			r14.GetOrDefault(defaultV14),
+			r15.GetOrDefault(defaultV15),
+			r16.GetOrDefault(defaultV16),
+		)
+	})
+}
+
+// MaxTupleArity is the biggest N of TupleN, so it's the most values a func
+// wrapped by go-wrap can return.
+const MaxTupleArity = 9
+
+type Tuple2[T1, T2 any] struct {
+	V1 T1
+	V2 T2
+}
+
+func (t Tuple2[T1, T2]) Unpack() (T1, T2) {
+	return t.V1, t.V2
+}
+
+// Zip2 gathers the values of all the arguments into Tuple2, see AndX2.
+func Zip2[T1, T2 any](r1 Out[T1], r2 Out[T2]) Out[Tuple2[T1, T2]] {
+	return AndX2(r1, r2, func(v1 T1, v2 T2) Out[Tuple2[T1, T2]] {
+		return OK(Tuple2[T1, T2]{V1: v1, V2: v2})
+	})
+}
+
+func Zip2Async[T1, T2 any](r1 Out[T1], r2 Out[T2]) Out[Tuple2[T1, T2]] {
+	return Async(func() Out[Tuple2[T1, T2]] {
+		return Zip2(r1, r2)
+	})
+}
+
+// Unzip2 splits the result of Zip2 back, an error goes to all the parts.
+func Unzip2[T1, T2 any](r Out[Tuple2[T1, T2]]) (Out[T1], Out[T2]) {
+	v, err := r.Unwrap()
+	if err != nil {
+		return Err[T1](err), Err[T2](err)
+	}
+	return OK(v.V1), OK(v.V2)
+}
+
+type Tuple3[T1, T2, T3 any] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+}
+
+func (t Tuple3[T1, T2, T3]) Unpack() (T1, T2, T3) {
+	return t.V1, t.V2, t.V3
+}
+
+// Zip3 gathers the values of all the arguments into Tuple3, see AndX3.
+func Zip3[T1, T2, T3 any](r1 Out[T1], r2 Out[T2], r3 Out[T3]) Out[Tuple3[T1, T2, T3]] {
+	return AndX3(r1, r2, r3, func(v1 T1, v2 T2, v3 T3) Out[Tuple3[T1, T2, T3]] {
+		return OK(Tuple3[T1, T2, T3]{V1: v1, V2: v2, V3: v3})
+	})
+}
+
+func Zip3Async[T1, T2, T3 any](r1 Out[T1], r2 Out[T2], r3 Out[T3]) Out[Tuple3[T1, T2, T3]] {
+	return Async(func() Out[Tuple3[T1, T2, T3]] {
+		return Zip3(r1, r2, r3)
+	})
+}
+
+// Unzip3 splits the result of Zip3 back, an error goes to all the parts.
+func Unzip3[T1, T2, T3 any](r Out[Tuple3[T1, T2, T3]]) (Out[T1], Out[T2], Out[T3]) {
+	v, err := r.Unwrap()
+	if err != nil {
+		return Err[T1](err), Err[T2](err), Err[T3](err)
+	}
+	return OK(v.V1), OK(v.V2), OK(v.V3)
+}
+
+type Tuple4[T1, T2, T3, T4 any] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+}
+
+func (t Tuple4[T1, T2, T3, T4]) Unpack() (T1, T2, T3, T4) {
+	return t.V1, t.V2, t.V3, t.V4
+}
+
+// Zip4 gathers the values of all the arguments into Tuple4, see AndX4.
+func Zip4[T1, T2, T3, T4 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4]) Out[Tuple4[T1, T2, T3, T4]] {
+	return AndX4(r1, r2, r3, r4, func(v1 T1, v2 T2, v3 T3, v4 T4) Out[Tuple4[T1, T2, T3, T4]] {
+		return OK(Tuple4[T1, T2, T3, T4]{V1: v1, V2: v2, V3: v3, V4: v4})
+	})
+}
+
+func Zip4Async[T1, T2, T3, T4 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4]) Out[Tuple4[T1, T2, T3, T4]] {
+	return Async(func() Out[Tuple4[T1, T2, T3, T4]] {
+		return Zip4(r1, r2, r3, r4)
+	})
+}
+
+// Unzip4 splits the result of Zip4 back, an error goes to all the parts.
+func Unzip4[T1, T2, T3, T4 any](r Out[Tuple4[T1, T2, T3, T4]]) (Out[T1], Out[T2], Out[T3], Out[T4]) {
+	v, err := r.Unwrap()
+	if err != nil {
+		return Err[T1](err), Err[T2](err), Err[T3](err), Err[T4](err)
+	}
+	return OK(v.V1), OK(v.V2), OK(v.V3), OK(v.V4)
+}
+
+type Tuple5[T1, T2, T3, T4, T5 any] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+	V5 T5
+}
+
+func (t Tuple5[T1, T2, T3, T4, T5]) Unpack() (T1, T2, T3, T4, T5) {
+	return t.V1, t.V2, t.V3, t.V4, t.V5
+}
+
+// Zip5 gathers the values of all the arguments into Tuple5, see AndX5.
+func Zip5[T1, T2, T3, T4, T5 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5]) Out[Tuple5[T1, T2, T3, T4, T5]] {
+	return AndX5(r1, r2, r3, r4, r5, func(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5) Out[Tuple5[T1, T2, T3, T4, T5]] {
+		return OK(Tuple5[T1, T2, T3, T4, T5]{V1: v1, V2: v2, V3: v3, V4: v4, V5: v5})
+	})
+}
+
+func Zip5Async[T1, T2, T3, T4, T5 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5]) Out[Tuple5[T1, T2, T3, T4, T5]] {
+	return Async(func() Out[Tuple5[T1, T2, T3, T4, T5]] {
+		return Zip5(r1, r2, r3, r4, r5)
+	})
+}
+
+// Unzip5 splits the result of Zip5 back, an error goes to all the parts.
+func Unzip5[T1, T2, T3, T4, T5 any](r Out[Tuple5[T1, T2, T3, T4, T5]]) (Out[T1], Out[T2], Out[T3], Out[T4], Out[T5]) {
+	v, err := r.Unwrap()
+	if err != nil {
+		return Err[T1](err), Err[T2](err), Err[T3](err), Err[T4](err), Err[T5](err)
+	}
+	return OK(v.V1), OK(v.V2), OK(v.V3), OK(v.V4), OK(v.V5)
+}
+
+type Tuple6[T1, T2, T3, T4, T5, T6 any] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+	V5 T5
+	V6 T6
+}
+
+func (t Tuple6[T1, T2, T3, T4, T5, T6]) Unpack() (T1, T2, T3, T4, T5, T6) {
+	return t.V1, t.V2, t.V3, t.V4, t.V5, t.V6
+}
+
+// Zip6 gathers the values of all the arguments into Tuple6, see AndX6.
+func Zip6[T1, T2, T3, T4, T5, T6 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6]) Out[Tuple6[T1, T2, T3, T4, T5, T6]] {
+	return AndX6(r1, r2, r3, r4, r5, r6, func(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6) Out[Tuple6[T1, T2, T3, T4, T5, T6]] {
+		return OK(Tuple6[T1, T2, T3, T4, T5, T6]{V1: v1, V2: v2, V3: v3, V4: v4, V5: v5, V6: v6})
+	})
+}
+
+func Zip6Async[T1, T2, T3, T4, T5, T6 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6]) Out[Tuple6[T1, T2, T3, T4, T5, T6]] {
+	return Async(func() Out[Tuple6[T1, T2, T3, T4, T5, T6]] {
+		return Zip6(r1, r2, r3, r4, r5, r6)
+	})
+}
+
+// Unzip6 splits the result of Zip6 back, an error goes to all the parts.
+func Unzip6[T1, T2, T3, T4, T5, T6 any](r Out[Tuple6[T1, T2, T3, T4, T5, T6]]) (Out[T1], Out[T2], Out[T3], Out[T4], Out[T5], Out[T6]) {
+	v, err := r.Unwrap()
+	if err != nil {
+		return Err[T1](err), Err[T2](err), Err[T3](err), Err[T4](err), Err[T5](err), Err[T6](err)
+	}
+	return OK(v.V1), OK(v.V2), OK(v.V3), OK(v.V4), OK(v.V5), OK(v.V6)
+}
+
+type Tuple7[T1, T2, T3, T4, T5, T6, T7 any] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+	V5 T5
+	V6 T6
+	V7 T7
+}
+
+func (t Tuple7[T1, T2, T3, T4, T5, T6, T7]) Unpack() (T1, T2, T3, T4, T5, T6, T7) {
+	return t.V1, t.V2, t.V3, t.V4, t.V5, t.V6, t.V7
+}
+
+// Zip7 gathers the values of all the arguments into Tuple7, see AndX7.
+func Zip7[T1, T2, T3, T4, T5, T6, T7 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7]) Out[Tuple7[T1, T2, T3, T4, T5, T6, T7]] {
+	return AndX7(r1, r2, r3, r4, r5, r6, r7, func(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6, v7 T7) Out[Tuple7[T1, T2, T3, T4, T5, T6, T7]] {
+		return OK(Tuple7[T1, T2, T3, T4, T5, T6, T7]{V1: v1, V2: v2, V3: v3, V4: v4, V5: v5, V6: v6, V7: v7})
+	})
+}
+
+func Zip7Async[T1, T2, T3, T4, T5, T6, T7 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7]) Out[Tuple7[T1, T2, T3, T4, T5, T6, T7]] {
+	return Async(func() Out[Tuple7[T1, T2, T3, T4, T5, T6, T7]] {
+		return Zip7(r1, r2, r3, r4, r5, r6, r7)
+	})
+}
+
+// Unzip7 splits the result of Zip7 back, an error goes to all the parts.
+func Unzip7[T1, T2, T3, T4, T5, T6, T7 any](r Out[Tuple7[T1, T2, T3, T4, T5, T6, T7]]) (Out[T1], Out[T2], Out[T3], Out[T4], Out[T5], Out[T6], Out[T7]) {
+	v, err := r.Unwrap()
+	if err != nil {
+		return Err[T1](err), Err[T2](err), Err[T3](err), Err[T4](err), Err[T5](err), Err[T6](err), Err[T7](err)
+	}
+	return OK(v.V1), OK(v.V2), OK(v.V3), OK(v.V4), OK(v.V5), OK(v.V6), OK(v.V7)
+}
+
+type Tuple8[T1, T2, T3, T4, T5, T6, T7, T8 any] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+	V5 T5
+	V6 T6
+	V7 T7
+	V8 T8
+}
+
+func (t Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]) Unpack() (T1, T2, T3, T4, T5, T6, T7, T8) {
+	return t.V1, t.V2, t.V3, t.V4, t.V5, t.V6, t.V7, t.V8
+}
+
+// Zip8 gathers the values of all the arguments into Tuple8, see AndX8.
+func Zip8[T1, T2, T3, T4, T5, T6, T7, T8 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8]) Out[Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]] {
+	return AndX8(r1, r2, r3, r4, r5, r6, r7, r8, func(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6, v7 T7, v8 T8) Out[Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]] {
+		return OK(Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]{V1: v1, V2: v2, V3: v3, V4: v4, V5: v5, V6: v6, V7: v7, V8: v8})
+	})
+}
+
+func Zip8Async[T1, T2, T3, T4, T5, T6, T7, T8 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8]) Out[Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]] {
+	return Async(func() Out[Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]] {
+		return Zip8(r1, r2, r3, r4, r5, r6, r7, r8)
+	})
+}
+
+// Unzip8 splits the result of Zip8 back, an error goes to all the parts.
+func Unzip8[T1, T2, T3, T4, T5, T6, T7, T8 any](r Out[Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]]) (Out[T1], Out[T2], Out[T3], Out[T4], Out[T5], Out[T6], Out[T7], Out[T8]) {
+	v, err := r.Unwrap()
+	if err != nil {
+		return Err[T1](err), Err[T2](err), Err[T3](err), Err[T4](err), Err[T5](err), Err[T6](err), Err[T7](err), Err[T8](err)
+	}
+	return OK(v.V1), OK(v.V2), OK(v.V3), OK(v.V4), OK(v.V5), OK(v.V6), OK(v.V7), OK(v.V8)
+}
+
+type Tuple9[T1, T2, T3, T4, T5, T6, T7, T8, T9 any] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+	V5 T5
+	V6 T6
+	V7 T7
+	V8 T8
+	V9 T9
+}
+
+func (t Tuple9[T1, T2, T3, T4, T5, T6, T7, T8, T9]) Unpack() (T1, T2, T3, T4, T5, T6, T7, T8, T9) {
+	return t.V1, t.V2, t.V3, t.V4, t.V5, t.V6, t.V7, t.V8, t.V9
+}
+
+// Zip9 gathers the values of all the arguments into Tuple9, see AndX9.
+func Zip9[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9]) Out[Tuple9[T1, T2, T3, T4, T5, T6, T7, T8, T9]] {
+	return AndX9(r1, r2, r3, r4, r5, r6, r7, r8, r9, func(v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6, v7 T7, v8 T8, v9 T9) Out[Tuple9[T1, T2, T3, T4, T5, T6, T7, T8, T9]] {
+		return OK(Tuple9[T1, T2, T3, T4, T5, T6, T7, T8, T9]{V1: v1, V2: v2, V3: v3, V4: v4, V5: v5, V6: v6, V7: v7, V8: v8, V9: v9})
+	})
+}
+
+func Zip9Async[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](r1 Out[T1], r2 Out[T2], r3 Out[T3], r4 Out[T4], r5 Out[T5], r6 Out[T6], r7 Out[T7], r8 Out[T8], r9 Out[T9]) Out[Tuple9[T1, T2, T3, T4, T5, T6, T7, T8, T9]] {
+	return Async(func() Out[Tuple9[T1, T2, T3, T4, T5, T6, T7, T8, T9]] {
+		return Zip9(r1, r2, r3, r4, r5, r6, r7, r8, r9)
+	})
+}
+
+// Unzip9 splits the result of Zip9 back, an error goes to all the parts.
+func Unzip9[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](r Out[Tuple9[T1, T2, T3, T4, T5, T6, T7, T8, T9]]) (Out[T1], Out[T2], Out[T3], Out[T4], Out[T5], Out[T6], Out[T7], Out[T8], Out[T9]) {
+	v, err := r.Unwrap()
+	if err != nil {
+		return Err[T1](err), Err[T2](err), Err[T3](err), Err[T4](err), Err[T5](err), Err[T6](err), Err[T7](err), Err[T8](err), Err[T9](err)
+	}
+	return OK(v.V1), OK(v.V2), OK(v.V3), OK(v.V4), OK(v.V5), OK(v.V6), OK(v.V7), OK(v.V8), OK(v.V9)
+}
@@ -0,0 +1,96 @@
+package wraptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/catmorte/go-wrap/pkg/wrap"
+)
+
+// UpdateEnv names the environment variable which makes AssertGolden rewrite
+// golden files instead of comparing with them, e.g. WRAPTEST_UPDATE=1 go test.
+const UpdateEnv = "WRAPTEST_UPDATE"
+
+// RequireOK returns the value of r or stops the test if it's Err.
+func RequireOK[T any](t testing.TB, r wrap.Out[T]) T {
+	t.Helper()
+	v, err := r.Unwrap()
+	if err != nil {
+		t.Fatalf("wraptest: expected OK, got error: %v", err)
+	}
+	return v
+}
+
+// RequireErrIs stops the test unless the error of r matches target, see errors.Is.
+func RequireErrIs(t testing.TB, r wrap.ErrorContainer, target error) {
+	t.Helper()
+	err := r.ErrorOrNil()
+	if err == nil {
+		t.Fatalf("wraptest: expected error %q, got OK", target)
+	}
+	if !errors.Is(err, target) {
+		t.Fatalf("wraptest: expected error %q, got: %v", target, err)
+	}
+}
+
+// AssertAllOK reports every element of r which is Err together with its index.
+func AssertAllOK[T any](t testing.TB, r []wrap.Out[T]) {
+	t.Helper()
+	for i, v := range r {
+		if err := v.ErrorOrNil(); err != nil {
+			t.Errorf("wraptest: expected OK at #%d, got error: %v", i, err)
+		}
+	}
+}
+
+// Eventually waits for r at most timeout and returns its value, the test is
+// stopped if r isn't ready in time or is Err. Under a Scheduler (see Use and
+// Interleavings) its tasks run while Eventually waits.
+func Eventually[T any](t testing.TB, r wrap.Out[T], timeout time.Duration) T {
+	t.Helper()
+	v, err := r.TryUnwrap(timeout)
+	if errors.Is(err, wrap.ErrTimeout) {
+		t.Fatalf("wraptest: not ready in %v", timeout)
+	}
+	if err != nil {
+		t.Fatalf("wraptest: expected OK, got error: %v", err)
+	}
+	return v
+}
+
+// AssertGolden joins r with wrap.JoinAll, so every failed element is reported
+// with its index, and compares the values encoded as indented JSON with the
+// content of the golden file at path. Set UpdateEnv to write the file instead.
+func AssertGolden[T any](t testing.TB, r []wrap.Out[T], path string) {
+	t.Helper()
+	values, err := wrap.JoinAll(r).Unwrap()
+	if err != nil {
+		t.Fatalf("wraptest: expected OK, got errors:\n%v", err)
+	}
+	got, err := json.MarshalIndent(values, "", "\t")
+	if err != nil {
+		t.Fatalf("wraptest: unable to encode values: %v", err)
+	}
+	got = append(got, '\n')
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("wraptest: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("wraptest: %v", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("wraptest: %v (set %s=1 to create it)", err, UpdateEnv)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("wraptest: values differ from %s\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
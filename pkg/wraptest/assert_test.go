@@ -0,0 +1,29 @@
+package wraptest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/catmorte/go-wrap/pkg/wrap"
+)
+
+func TestEventually(t *testing.T) {
+	pipeline := func() wrap.Out[int] {
+		return wrap.AndAsync(wrap.AndAsync(wrap.Async(func() wrap.Out[int] {
+			return wrap.OK(1)
+		}), double), double)
+	}
+	t.Run("use", func(t *testing.T) {
+		Use(t, NewScheduler(Seed(t)))
+		if v := Eventually(t, pipeline(), time.Minute); v != 4 {
+			t.Fatalf("got %v, want 4", v)
+		}
+	})
+	t.Run("interleavings", func(t *testing.T) {
+		Interleavings(t, 10, func(t *testing.T, s *Scheduler) {
+			if v := Eventually(t, pipeline(), time.Minute); v != 4 {
+				t.Fatalf("got %v, want 4", v)
+			}
+		})
+	})
+}
@@ -0,0 +1,104 @@
+// Package wraptest helps to test code built on top of wrap.
+package wraptest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/catmorte/go-wrap/pkg/wrap"
+)
+
+// SeedEnv names the environment variable which makes Interleavings replay
+// a single seed, e.g. WRAPTEST_SEED=42 go test -run TestPipeline.
+const SeedEnv = "WRAPTEST_SEED"
+
+// Scheduler is a wrap.Scheduler which picks a random ready task every step,
+// so the same seed gives the same interleaving of async handlers every time.
+type Scheduler struct {
+	*wrap.Scheduler
+
+	seed int64
+}
+
+func NewScheduler(seed int64) *Scheduler {
+	rnd := rand.New(rand.NewSource(seed))
+	return &Scheduler{
+		Scheduler: wrap.NewSchedulerFunc(rnd.Intn),
+		seed:      seed,
+	}
+}
+
+func (s *Scheduler) Seed() int64 {
+	return s.seed
+}
+
+// Context returns ctx which makes AsyncCtx and the **AsyncCtx handlers use s.
+func (s *Scheduler) Context(ctx context.Context) context.Context {
+	return wrap.WithExecutor(ctx, s)
+}
+
+// Use makes e the default executor of wrap until the end of the test. As it's
+// global, tests which call Use can't run in parallel.
+func Use(t testing.TB, e wrap.Executor) {
+	t.Helper()
+	prev := wrap.SetDefaultExecutor(e)
+	t.Cleanup(func() {
+		wrap.SetDefaultExecutor(prev)
+	})
+}
+
+// Seed returns the seed from SeedEnv if it's set or a random one otherwise,
+// the seed is logged if the test fails.
+func Seed(t testing.TB) int64 {
+	t.Helper()
+	seed := seedFromEnv(t)
+	logSeed(t, seed)
+	return seed
+}
+
+func seedFromEnv(t testing.TB) int64 {
+	t.Helper()
+	v := os.Getenv(SeedEnv)
+	if v == "" {
+		return time.Now().UnixNano()
+	}
+	seed, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		t.Fatalf("wraptest: invalid %s: %v", SeedEnv, err)
+	}
+	return seed
+}
+
+// logSeed logs how to replay t with seed once it fails.
+func logSeed(t testing.TB, seed int64) {
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Logf("wraptest: replay with %s=%d", SeedEnv, seed)
+		}
+	})
+}
+
+// Interleavings runs fn as n subtests, each one with its own Scheduler made
+// the default executor (see Use). The seeds go one after another starting from
+// Seed(t), and a failed subtest logs its own, so setting SeedEnv to it replays
+// the subtest.
+func Interleavings(t *testing.T, n int, fn func(t *testing.T, s *Scheduler)) {
+	t.Helper()
+	seed := seedFromEnv(t)
+	if os.Getenv(SeedEnv) != "" {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		s := NewScheduler(seed + int64(i))
+		t.Run(fmt.Sprintf("seed=%d", s.Seed()), func(t *testing.T) {
+			logSeed(t, s.Seed())
+			Use(t, s)
+			fn(t, s)
+		})
+	}
+}
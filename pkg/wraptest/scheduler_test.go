@@ -0,0 +1,214 @@
+package wraptest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/catmorte/go-wrap/pkg/wrap"
+)
+
+// settle waits for r, so a deadlocked interleaving fails the test instead of
+// hanging it.
+func settle[T any](t *testing.T, r wrap.Out[T]) wrap.Out[T] {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.ErrorOrNil()
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("deadlock: the result isn't settled")
+	}
+	return r
+}
+
+var errTest = errors.New("test error")
+
+func double(v int) wrap.Out[int] {
+	return wrap.OK(v * 2)
+}
+
+func sum(v []int) wrap.Out[[]int] {
+	res := 0
+	for _, v := range v {
+		res += v
+	}
+	return wrap.OK([]int{res})
+}
+
+func TestInterleavings(t *testing.T) {
+	tests := []struct {
+		name     string
+		pipeline func() wrap.Out[[]int]
+		want     []int
+	}{
+		{
+			name: "range each join",
+			pipeline: func() wrap.Out[[]int] {
+				return wrap.JoinAsync(wrap.EachAsync(wrap.RangeAsync(3, wrap.OK[int]), double))
+			},
+			want: []int{0, 2, 4},
+		},
+		{
+			name: "and chain",
+			pipeline: func() wrap.Out[[]int] {
+				res := wrap.AndAsync(wrap.AndAsync(wrap.Async(func() wrap.Out[int] {
+					return wrap.OK(1)
+				}), double), double)
+				return wrap.Map(res, func(v int) []int {
+					return []int{v}
+				})
+			},
+			want: []int{4},
+		},
+		{
+			name: "sliced",
+			pipeline: func() wrap.Out[[]int] {
+				res := wrap.JoinAsync(wrap.SlicedAsync(2, wrap.RangeAsync(5, wrap.OK[int]), sum))
+				return wrap.Map(res, func(v [][]int) []int {
+					sums := []int{}
+					for _, v := range v {
+						sums = append(sums, v...)
+					}
+					return sums
+				})
+			},
+			want: []int{1, 5, 4},
+		},
+		{
+			name: "and x2",
+			pipeline: func() wrap.Out[[]int] {
+				a := wrap.AndAsync(wrap.OK(1), double)
+				b := wrap.AndAsync(wrap.OK(2), double)
+				return wrap.AndX2Async(a, b, func(a, b int) wrap.Out[[]int] {
+					return wrap.OK([]int{a, b})
+				})
+			},
+			want: []int{2, 4},
+		},
+		{
+			name: "first ok",
+			pipeline: func() wrap.Out[[]int] {
+				return wrap.Async(func() wrap.Out[[]int] {
+					return wrap.FirstOK([]wrap.Out[[]int]{
+						wrap.Async(func() wrap.Out[[]int] {
+							return wrap.Err[[]int](errTest)
+						}),
+						wrap.JoinAsync(wrap.EachAsync(wrap.RangeAsync(3, wrap.OK[int]), double)),
+					})
+				})
+			},
+			want: []int{0, 2, 4},
+		},
+		{
+			name: "race",
+			pipeline: func() wrap.Out[[]int] {
+				return wrap.RaceAsync(
+					wrap.JoinAsync(wrap.EachAsync(wrap.RangeAsync(2, wrap.OK[int]), double)),
+					wrap.JoinAsync(wrap.RangeAsync(2, double)),
+				)
+			},
+			want: []int{0, 2},
+		},
+		{
+			name: "select",
+			pipeline: func() wrap.Out[[]int] {
+				winner := wrap.SelectAsync(wrap.AndAsync(wrap.OK(1), double), wrap.Async(func() wrap.Out[string] {
+					return wrap.OK("")
+				}))
+				return wrap.Map(winner, func(int) []int {
+					return []int{1}
+				})
+			},
+			want: []int{1},
+		},
+		{
+			name: "join fail fast",
+			pipeline: func() wrap.Out[[]int] {
+				return wrap.JoinFailFastAsync(wrap.EachAsync(wrap.RangeAsync(3, wrap.OK[int]), double))
+			},
+			want: []int{0, 2, 4},
+		},
+		{
+			name: "timeout",
+			pipeline: func() wrap.Out[[]int] {
+				return wrap.WithTimeout(wrap.JoinAsync(wrap.EachAsync(wrap.RangeAsync(3, wrap.OK[int]), double)), time.Minute)
+			},
+			want: []int{0, 2, 4},
+		},
+		{
+			name: "lazy",
+			pipeline: func() wrap.Out[[]int] {
+				lazy := wrap.Lazy(func() wrap.Out[[]int] {
+					return wrap.JoinAsync(wrap.RangeAsync(3, double))
+				})
+				return wrap.RaceAsync(lazy)
+			},
+			want: []int{0, 2, 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Interleavings(t, 50, func(t *testing.T, s *Scheduler) {
+				got := RequireOK(t, settle(t, tt.pipeline()))
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			})
+		})
+	}
+}
+
+func TestSchedulerReplay(t *testing.T) {
+	order := func(seed int64) []int {
+		s := NewScheduler(seed)
+		res := []int{}
+		r := wrap.JoinAsync(wrap.EachAsyncOn(s, wrap.RangeAsyncOn(s, 10, wrap.OK[int]), func(v int) wrap.Out[int] {
+			res = append(res, v)
+			return wrap.OK(v)
+		}))
+		settle(t, r)
+		return res
+	}
+	for seed := int64(0); seed < 10; seed++ {
+		if a, b := order(seed), order(seed); !reflect.DeepEqual(a, b) {
+			t.Fatalf("seed %d: got %v, then %v", seed, a, b)
+		}
+	}
+}
+
+func TestTryUnwrap(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	Interleavings(t, 10, func(t *testing.T, s *Scheduler) {
+		v, err := wrap.AndAsync(wrap.Async(func() wrap.Out[int] {
+			return wrap.OK(1)
+		}), double).TryUnwrap(time.Minute)
+		if err != nil || v != 2 {
+			t.Fatalf("got %v, %v, want 2", v, err)
+		}
+		stuck := wrap.AsyncOn(wrap.GoExecutor, func() wrap.Out[int] {
+			<-block
+			return wrap.OK(1)
+		})
+		_, err = wrap.AndAsync(stuck, double).TryUnwrap(10 * time.Millisecond)
+		if !errors.Is(err, wrap.ErrTimeout) {
+			t.Fatalf("got %v, want %v", err, wrap.ErrTimeout)
+		}
+	})
+}
+
+func TestInterleavingsSeed(t *testing.T) {
+	t.Setenv(SeedEnv, "42")
+	seeds := []int64{}
+	Interleavings(t, 10, func(t *testing.T, s *Scheduler) {
+		seeds = append(seeds, s.Seed())
+	})
+	if want := []int64{42}; !reflect.DeepEqual(seeds, want) {
+		t.Fatalf("got seeds %v, want %v", seeds, want)
+	}
+}